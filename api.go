@@ -2,24 +2,123 @@
 // simple API with the most essential features.
 package argon2
 
-// Key derives an Argon2(i|d) hash from the input.
-func Key(password, salt []byte, iterations, parallelism, memory uint32, keyLength int, variant Variant) ([]byte, error) {
+import (
+	"context"
+)
+
+// Params contains all the inputs of a single derivation.
+type Params struct {
+	Password       []byte
+	Salt           []byte
+	Secret         []byte // Optional key, mixed into the initial hash
+	AssociatedData []byte // Optional associated data, mixed into the initial hash
+
+	Time      uint32 // Number of passes over the memory
+	Memory    uint32 // Memory cost in KiB
+	Lanes     uint32 // Degree of parallelism
+	Threads   uint32 // Maximum number of threads, defaults to Lanes
+	KeyLength uint32 // Length of the output in bytes
+
+	Variant Variant
+	Version Version // Defaults to DefaultVersion
+	Flags   Flags   // Wiping of the password and secret
+
+	Progress ProgressFunc // Optional, called after every filled slice
+}
+
+// ProgressFunc is called after each of the four slices of every pass over
+// the memory is filled, the completed fraction of the work is
+// (pass*4 + slice + 1) / (passes*4). It is called from one of the goroutines
+// filling the memory and the derivation does not continue until it returns.
+type ProgressFunc func(pass, slice, passes uint32)
+
+// Key derives an Argon2(i|d|id) hash from the input. A zero version selects
+// DefaultVersion.
+func Key(password, salt []byte, iterations, parallelism, memory uint32, keyLength int, variant Variant, version Version) ([]byte, error) {
+	return KeyContext(context.Background(), password, salt, iterations, parallelism, memory, keyLength, variant, version)
+}
+
+// KeyContext is Key, but it stops between the slices of the memory filling
+// and returns ctx.Err() once the context is done.
+func KeyContext(ctx context.Context, password, salt []byte, iterations, parallelism, memory uint32, keyLength int, variant Variant, version Version) ([]byte, error) {
+	// Params only holds 32-bit lengths, check the bounds before converting.
+	// A negative length is reported as 0, ParamError values are unsigned.
+	if keyLength < 0 {
+		return nil, &ParamError{Field: "KeyLength", Value: 0, Min: minOutlen, Max: maxOutlen, Err: ErrOutputTooShort}
+	}
+	if uint64(keyLength) > maxOutlen {
+		return nil, &ParamError{Field: "KeyLength", Value: uint64(keyLength), Min: minOutlen, Max: maxOutlen, Err: ErrOutputTooLong}
+	}
+
+	return DeriveContext(ctx, Params{
+		Password:  password,
+		Salt:      salt,
+		Time:      iterations,
+		Memory:    memory,
+		Lanes:     parallelism,
+		KeyLength: uint32(keyLength),
+		Variant:   variant,
+		Version:   version,
+	})
+}
+
+// Derive derives an Argon2 hash using the passed parameters.
+func Derive(params Params) ([]byte, error) {
+	return DeriveContext(context.Background(), params)
+}
+
+// DeriveContext is Derive, but it stops between the slices of the memory
+// filling and returns ctx.Err() once the context is done. In CPU profiles,
+// the time spent filling every lane carries an "argon2.lane" label on top of
+// the pprof labels of ctx.
+func DeriveContext(ctx context.Context, params Params) ([]byte, error) {
+	if err := checkSelfTest(); err != nil {
+		return nil, err
+	}
+
 	// Prepare an output slice
-	output := make([]byte, keyLength)
-
-	ctx := &context{
-		out:        output,
-		pwd:        password,
-		salt:       salt,
-		timeCost:   iterations,
-		memoryCost: memory,
-		lanes:      parallelism,
-		threads:    parallelism,
+	output := make([]byte, params.KeyLength)
+
+	actx := params.argon2Context(output)
+	actx.labels = ctx
+	if ctx.Done() != nil {
+		actx.cancel = ctx
 	}
 
-	if err := core(ctx, variant); err != nil {
+	if err := core(&actx, params.Variant, params.Version); err != nil {
 		return nil, err
 	}
 
 	return output, nil
 }
+
+// ValidateParams checks all the parameters at once and returns every value
+// outside of its bounds, nil if the parameters are valid. The errors are the
+// same as the ones returned by Derive.
+func ValidateParams(params Params) []*ParamError {
+	ctx := params.argon2Context(nil)
+
+	return append(typeErrors(params.Variant, params.Version), inputErrors(&ctx, uint64(params.KeyLength))...)
+}
+
+// argon2Context converts the parameters into the internal argon2 context.
+func (params *Params) argon2Context(out []byte) argon2Context {
+	threads := params.Threads
+	if threads == 0 {
+		threads = params.Lanes
+	}
+
+	return argon2Context{
+		out:        out,
+		pwd:        params.Password,
+		salt:       params.Salt,
+		secret:     params.Secret,
+		ad:         params.AssociatedData,
+		timeCost:   params.Time,
+		memoryCost: params.Memory,
+		lanes:      params.Lanes,
+		threads:    threads,
+		flags:      params.Flags,
+		progress:   params.Progress,
+	}
+}
@@ -0,0 +1,224 @@
+package argon2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"runtime/pprof"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestFlagsClear(t *testing.T) {
+	var (
+		password = []byte("password")
+		secret   = []byte("pepper")
+		salt     = []byte("somesalt")
+		ad       = []byte("user@example.com")
+	)
+
+	derive := func(flags Flags, pwd, sec []byte) []byte {
+		out, err := Derive(Params{
+			Password:       pwd,
+			Salt:           salt,
+			Secret:         sec,
+			AssociatedData: ad,
+			Time:           1,
+			Memory:         64,
+			Lanes:          2,
+			KeyLength:      32,
+			Variant:        Argon2id,
+			Flags:          flags,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	want := derive(0, bytes.Clone(password), bytes.Clone(secret))
+
+	for _, flags := range []Flags{0, FlagClearPassword, FlagClearSecret, FlagClearPassword | FlagClearSecret} {
+		pwd, sec := bytes.Clone(password), bytes.Clone(secret)
+
+		if out := derive(flags, pwd, sec); !bytes.Equal(out, want) {
+			t.Errorf("flags %d: output %x, want %x", flags, out, want)
+		}
+
+		cleared := make([]byte, len(password))
+		if flags&FlagClearPassword != 0 {
+			if !bytes.Equal(pwd, cleared) {
+				t.Errorf("flags %d: password not cleared: %q", flags, pwd)
+			}
+		} else if !bytes.Equal(pwd, password) {
+			t.Errorf("flags %d: password modified: %q", flags, pwd)
+		}
+
+		cleared = make([]byte, len(secret))
+		if flags&FlagClearSecret != 0 {
+			if !bytes.Equal(sec, cleared) {
+				t.Errorf("flags %d: secret not cleared: %q", flags, sec)
+			}
+		} else if !bytes.Equal(sec, secret) {
+			t.Errorf("flags %d: secret modified: %q", flags, sec)
+		}
+	}
+
+	if string(salt) != "somesalt" || string(ad) != "user@example.com" {
+		t.Errorf("salt %q or associated data %q modified", salt, ad)
+	}
+}
+
+// cancelParams take seconds to derive, far longer than the deadlines below
+var cancelParams = Params{
+	Password:  []byte("password"),
+	Salt:      []byte("somesalt"),
+	Time:      64,
+	Memory:    1 << 16,
+	Lanes:     1,
+	KeyLength: 32,
+	Variant:   Argon2id,
+}
+
+func TestDeriveContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := DeriveContext(ctx, cancelParams); err != context.Canceled {
+		t.Errorf("cancelled context: %v, want context.Canceled", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := DeriveContext(ctx, cancelParams); err != context.DeadlineExceeded {
+		t.Errorf("expired context: %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("returned %v after the deadline", elapsed)
+	}
+}
+
+func TestDeriveContextLabels(t *testing.T) {
+	params := testPolicy
+	params.Password = []byte("password")
+	params.Salt = []byte("somesalt")
+	params.KeyLength = 32
+	params.Lanes, params.Threads = 4, 2
+
+	// The progress function runs while all the threads are alive, the
+	// profile shows them with the labels of the caller and of the last
+	// lane they filled
+	var profiles bytes.Buffer
+	params.Progress = func(pass, slice, passes uint32) {
+		pprof.Lookup("goroutine").WriteTo(&profiles, 1)
+	}
+
+	ctx := pprof.WithLabels(context.Background(), pprof.Labels("request", "42"))
+	if _, err := DeriveContext(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, lane := range []string{"2", "3"} {
+		labels := `"argon2.lane":"` + lane + `", "request":"42"`
+		if !bytes.Contains(profiles.Bytes(), []byte(labels)) {
+			t.Errorf("no goroutine labeled %s", labels)
+		}
+	}
+}
+
+func TestKeyLengthErrors(t *testing.T) {
+	tests := []struct {
+		keyLength int
+		value     uint64
+		err       error
+	}{
+		{-1, 0, ErrOutputTooShort},
+		{0, 0, ErrOutputTooShort},
+		{3, 3, ErrOutputTooShort},
+	}
+	if strconv.IntSize == 64 {
+		tooLong := uint64(maxOutlen) + 1
+		tests = append(tests, struct {
+			keyLength int
+			value     uint64
+			err       error
+		}{int(tooLong), tooLong, ErrOutputTooLong})
+	}
+
+	for _, tt := range tests {
+		_, err := Key([]byte("password"), []byte("somesalt"), 1, 1, 64, tt.keyLength, Argon2id, V13)
+
+		var paramErr *ParamError
+		if !errors.As(err, &paramErr) {
+			t.Errorf("%d: %v, want a *ParamError", tt.keyLength, err)
+			continue
+		}
+
+		if paramErr.Field != "KeyLength" || paramErr.Value != tt.value {
+			t.Errorf("%d: %s is %d, want KeyLength is %d", tt.keyLength, paramErr.Field, paramErr.Value, tt.value)
+		}
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%d: %v, want %v", tt.keyLength, err, tt.err)
+		}
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	valid := Params{
+		Salt:      []byte("somesalt"),
+		Time:      1,
+		Memory:    64,
+		Lanes:     1,
+		KeyLength: 32,
+		Variant:   Argon2id,
+	}
+	if errs := ValidateParams(valid); errs != nil {
+		t.Errorf("valid parameters: %v", errs)
+	}
+
+	invalid := Params{
+		Salt:      []byte("salt"),
+		Time:      0,
+		Memory:    4,
+		Lanes:     0,
+		KeyLength: 2,
+		Variant:   Variant(7),
+		Version:   Version(12),
+	}
+
+	want := []struct {
+		field string
+		err   error
+	}{
+		{"Variant", ErrIncorrectType},
+		{"Version", ErrIncorrectVersion},
+		{"KeyLength", ErrOutputTooShort},
+		{"Salt", ErrSaltTooShort},
+		{"Memory", ErrMemoryTooLittle},
+		{"Time", ErrTimeTooSmall},
+		{"Lanes", ErrLanesTooFew},
+		{"Threads", ErrThreadsTooFew},
+	}
+
+	errs := ValidateParams(invalid)
+	if len(errs) != len(want) {
+		t.Fatalf("%d errors %v, want %d", len(errs), errs, len(want))
+	}
+
+	for i, err := range errs {
+		if err.Field != want[i].field || !errors.Is(err, want[i].err) {
+			t.Errorf("error %d: %v, want %s: %v", i, err, want[i].field, want[i].err)
+		}
+	}
+
+	// Derive stops at the first one
+	_, err := Derive(invalid)
+
+	var paramErr *ParamError
+	if !errors.As(err, &paramErr) || *paramErr != *errs[0] {
+		t.Errorf("Derive: %v, want %v", err, errs[0])
+	}
+}
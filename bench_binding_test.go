@@ -1,3 +1,5 @@
+//go:build bench_bindings
+
 package argon2_test
 
 import (
@@ -16,7 +18,7 @@ func BenchmarkBConversion(b *testing.B) {
 	b.ReportAllocs()
 
 	for n := 0; n < b.N; n++ {
-		conv.Key(password, salt, 3, 4, 4096, 32, conv.Argon2i)
+		conv.Key(password, salt, 3, 4, 4096, 32, conv.Argon2i, conv.V13)
 	}
 }
 
@@ -1,3 +1,5 @@
+//go:build bench_bindings
+
 package argon2_test
 
 import (
@@ -11,7 +13,7 @@ func BenchmarkMConversion(b *testing.B) {
 	b.ReportAllocs()
 
 	for n := 0; n < b.N; n++ {
-		conv.Key(password, salt, 3, 4, 4096, 32, conv.Argon2d)
+		conv.Key(password, salt, 3, 4, 4096, 32, conv.Argon2d, conv.V10)
 	}
 }
 
@@ -0,0 +1,107 @@
+package argon2
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// fillMemoryBlocksPerSlice is the previous scheduler, which started new
+// goroutines for every slice instead of keeping them at a barrier. It is
+// only kept to compare the two.
+func fillMemoryBlocksPerSlice(ins *instance) error {
+	threads := ins.threads
+	if threads == 0 || threads > ins.lanes {
+		threads = ins.lanes
+	}
+
+	for r := uint32(0); r < ins.passes; r++ {
+		for s := uint32(0); s < syncPoints; s++ {
+			var wg sync.WaitGroup
+
+			for t := uint32(0); t < threads; t++ {
+				wg.Add(1)
+
+				go func(first uint32) {
+					defer wg.Done()
+
+					for l := first; l < ins.lanes; l += threads {
+						pos := position{
+							pass:  r,
+							lane:  l,
+							slice: uint8(s),
+							index: 0,
+						}
+
+						fillSegment(ins, &pos)
+					}
+				}(t)
+			}
+
+			wg.Wait()
+		}
+	}
+
+	return nil
+}
+
+func BenchmarkThreads(b *testing.B) {
+	schedulers := []struct {
+		name string
+		fill func(ins *instance) error
+	}{
+		{"barrier", fillMemoryBlocks},
+		{"per-slice", fillMemoryBlocksPerSlice},
+	}
+
+	for _, lanes := range []uint32{1, 4, 16} {
+		for _, threads := range []uint32{1, 4} {
+			if threads > lanes {
+				continue
+			}
+
+			params := Params{
+				Password:  []byte("password"),
+				Salt:      []byte("somesalt"),
+				Time:      3,
+				Memory:    4096,
+				Lanes:     lanes,
+				Threads:   threads,
+				KeyLength: 32,
+				Variant:   Argon2id,
+			}
+
+			for _, scheduler := range schedulers {
+				b.Run(fmt.Sprintf("p=%d/threads=%d/%s", lanes, threads, scheduler.name), func(b *testing.B) {
+					memoryBlocks, segmentLength := alignMemory(params.Memory, params.Lanes)
+					memory := make([]block, memoryBlocks)
+
+					actx := params.argon2Context(make([]byte, params.KeyLength))
+					actx.allocate = func(uint32) []block { return memory }
+
+					b.ReportAllocs()
+
+					for n := 0; n < b.N; n++ {
+						ins := instance{
+							passes:        params.Time,
+							memoryBlocks:  memoryBlocks,
+							segmentLength: segmentLength,
+							laneLength:    segmentLength * syncPoints,
+							lanes:         params.Lanes,
+							threads:       params.Threads,
+							variant:       params.Variant,
+							version:       V13,
+						}
+
+						if err := initialize(&ins, &actx); err != nil {
+							b.Fatal(err)
+						}
+						if err := scheduler.fill(&ins); err != nil {
+							b.Fatal(err)
+						}
+					}
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package argon2
+
+import (
+	"time"
+)
+
+// Maximum number of derivations used to find the number of passes
+const calibrationRounds = 8
+
+// Measurement is a single timed derivation performed by Calibrate.
+type Measurement struct {
+	Time     uint32
+	Memory   uint32
+	Duration time.Duration
+}
+
+// Calibrate searches for parameters that make a single derivation take as
+// long as possible without exceeding the target on the current host. Memory
+// is preferred over passes: it starts at maxMemory KiB with one pass, lowers
+// the memory until the target is met and then spends what is left of the
+// target on additional passes. All the derivations it ran are returned along
+// with the parameters. Like Derive, it returns ErrSelfTestFailed if the
+// self-test has failed.
+func Calibrate(target time.Duration, maxMemory, lanes uint32, variant Variant) (Params, []Measurement, error) {
+	if err := checkSelfTest(); err != nil {
+		return Params{}, nil, err
+	}
+
+	if target <= 0 {
+		return Params{}, nil, ErrIncorrectParameter
+	}
+
+	params := Params{
+		Time:      1,
+		Memory:    maxMemory,
+		Lanes:     lanes,
+		Threads:   lanes,
+		KeyLength: DefaultKeyLength,
+		Variant:   variant,
+		Version:   DefaultVersion,
+	}
+
+	floor := uint32(minMemory)
+	if floor < 8*lanes {
+		floor = 8 * lanes
+	}
+
+	var measurements []Measurement
+	measure := func() (time.Duration, error) {
+		duration, err := timeDerivation(&params)
+		if err != nil {
+			return 0, err
+		}
+
+		measurements = append(measurements, Measurement{
+			Time:     params.Time,
+			Memory:   params.Memory,
+			Duration: duration,
+		})
+		return duration, nil
+	}
+
+	/* 1. Lower the memory until a single pass fits in the target, the
+	   duration is roughly proportional to the memory */
+	duration, err := measure()
+	for err == nil && duration > target {
+		if params.Memory == floor {
+			return Params{}, measurements, ErrTargetTooShort
+		}
+
+		params.Memory = uint32(uint64(params.Memory) * uint64(target) / uint64(duration))
+		if params.Memory < floor {
+			params.Memory = floor
+		}
+
+		duration, err = measure()
+	}
+	if err != nil {
+		return Params{}, measurements, err
+	}
+
+	/* 2. Add passes, the duration is roughly proportional to their number.
+	   Every measurement refines the estimate, good holds the best
+	   parameters that met the target so far. A coarse clock may measure
+	   nothing at all, the passes are only doubled then. */
+	good := params
+	for i := 0; i < calibrationRounds; i++ {
+		passes := 2 * uint64(params.Time)
+		if duration > 0 {
+			passes = uint64(params.Time) * uint64(target) / uint64(duration)
+		}
+		if passes > maxTime {
+			passes = maxTime
+		}
+		if uint32(passes) <= good.Time || uint32(passes) == params.Time {
+			break
+		}
+
+		params.Time = uint32(passes)
+		if duration, err = measure(); err != nil {
+			return Params{}, measurements, err
+		}
+
+		if duration <= target {
+			good = params
+		}
+	}
+
+	return good, measurements, nil
+}
+
+// timeDerivation measures how long a single derivation takes.
+func timeDerivation(params *Params) (time.Duration, error) {
+	ctx := &argon2Context{
+		out:        make([]byte, params.KeyLength),
+		pwd:        make([]byte, DefaultKeyLength),
+		salt:       make([]byte, DefaultSaltLength),
+		timeCost:   params.Time,
+		memoryCost: params.Memory,
+		lanes:      params.Lanes,
+		threads:    params.Threads,
+	}
+
+	start := time.Now()
+	if err := core(ctx, params.Variant, params.Version); err != nil {
+		return 0, err
+	}
+
+	return time.Since(start), nil
+}
@@ -0,0 +1,35 @@
+package argon2
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalibrate(t *testing.T) {
+	params, measurements, err := Calibrate(20*time.Millisecond, 1024, 2, Argon2id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(measurements) == 0 {
+		t.Errorf("%d measurements", len(measurements))
+	}
+	if params.Memory < 16 || params.Memory > 1024 || params.Time < 1 || params.Lanes != 2 {
+		t.Errorf("unexpected parameters %+v", params)
+	}
+
+	if errs := ValidateParams(params); errs != nil {
+		t.Errorf("invalid parameters: %v", errs)
+	}
+}
+
+func TestCalibrateErrors(t *testing.T) {
+	if _, _, err := Calibrate(0, 1024, 1, Argon2id); err != ErrIncorrectParameter {
+		t.Errorf("zero target: %v, want ErrIncorrectParameter", err)
+	}
+
+	// Not even the minimum memory fits in a nanosecond
+	if _, _, err := Calibrate(time.Nanosecond, 1024, 1, Argon2id); err != ErrTargetTooShort {
+		t.Errorf("short target: %v, want ErrTargetTooShort", err)
+	}
+}
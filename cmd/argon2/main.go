@@ -0,0 +1,306 @@
+// Command argon2 hashes a password read from the standard input, with the
+// same arguments and output as the argon2 utility of the reference
+// implementation:
+//
+//	echo -n password | argon2 somesalt -id -t 2 -m 16 -p 4
+//
+// Like the reference, it does not strip a trailing newline from the password.
+//
+// The verify mode checks a password against an encoded hash, prompting for
+// the password without echo:
+//
+//	argon2 verify '$argon2id$v=19$m=65536,t=2,p=4$c29tZXNhbHQ$...'
+//
+// It exits with 0 if the password matches, 2 if it does not and 3 if the
+// encoded hash is malformed.
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pzduniak/argon2"
+)
+
+// Limits and defaults of the reference utility
+const (
+	maxPasswordLength = 128
+	defaultTime       = 3
+	defaultLogMemory  = 12
+	defaultLanes      = 1
+	defaultKeyLength  = 32
+)
+
+// Exit code of the reference when run without arguments, ARGON2_MISSING_ARGS
+const exitMissingArgs = -30
+
+const usage = `Usage:  %s [-h] salt [-i|-d|-id] [-t iterations] [-m log2(memory in KiB) | -k memory in KiB] [-p parallelism] [-l hash length] [-e|-r] [-v (10|13)]
+	Password is read from stdin
+        %s verify [encoded]
+	Password is prompted for, exits with 0 if it matches, 2 if not and 3 if the encoded hash is malformed
+Parameters:
+	salt		The salt to use, at least 8 characters
+	-i		Use Argon2i (this is the default)
+	-d		Use Argon2d instead of Argon2i
+	-id		Use Argon2id instead of Argon2i
+	-t N		Sets the number of iterations to N (default = %d)
+	-m N		Sets the memory usage of 2^N KiB (default %d)
+	-k N		Sets the memory usage of N KiB (default %d)
+	-p N		Sets parallelism to N threads (default %d)
+	-l N		Sets hash output length to N bytes (default %d)
+	-e		Output only encoded hash
+	-r		Output only the raw bytes of the hash
+	-v (10|13)	Argon2 version (defaults to the most recent version, currently %d)
+	-h		Print %s usage
+	encoded		The encoded hash to verify, read from stdin if missing
+`
+
+// options are the parsed command line arguments.
+type options struct {
+	salt        []byte
+	variant     argon2.Variant
+	version     argon2.Version
+	time        uint32
+	memory      uint32
+	parallelism uint32
+	keyLength   uint32
+	encodedOnly bool
+	rawOnly     bool
+}
+
+func main() {
+	os.Exit(run(os.Args, os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run is the whole utility, returning the exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	name := "argon2"
+	if len(args) > 0 {
+		name = args[0]
+		args = args[1:]
+	}
+
+	if len(args) == 0 || args[0] == "-h" {
+		fmt.Fprintf(stdout, usage, name, name, defaultTime, defaultLogMemory, 1<<defaultLogMemory,
+			defaultLanes, defaultKeyLength, argon2.V13, name)
+		if len(args) == 0 {
+			return exitMissingArgs
+		}
+		return 1
+	}
+
+	// Salts are at least 8 bytes long, so this is never a valid one
+	if args[0] == "verify" {
+		return verify(args[1:], stdin, stdout, stderr)
+	}
+
+	// Like the reference, read the password before parsing the options
+	password, err := readPassword(stdin)
+	if err != nil {
+		return fatal(stderr, err)
+	}
+
+	opts, err := parseArgs(args)
+	if err != nil {
+		return fatal(stderr, err)
+	}
+
+	if err := hash(stdout, opts, password); err != nil {
+		return fatal(stderr, err)
+	}
+
+	return 0
+}
+
+func parseArgs(args []string) (*options, error) {
+	opts := &options{
+		salt:        []byte(args[0]),
+		variant:     argon2.Argon2i,
+		version:     argon2.V13,
+		time:        defaultTime,
+		memory:      1 << defaultLogMemory,
+		parallelism: defaultLanes,
+		keyLength:   defaultKeyLength,
+	}
+
+	var memorySpecified bool
+
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+
+		// value returns the argument of the current flag
+		value := func() (uint64, error) {
+			if i+1 >= len(args) {
+				return 0, fmt.Errorf("missing %s argument", arg)
+			}
+			i++
+
+			n, err := strconv.ParseUint(args[i], 10, 32)
+			if err != nil || n == 0 {
+				return 0, fmt.Errorf("bad numeric input for %s", arg)
+			}
+			return n, nil
+		}
+
+		var (
+			n   uint64
+			err error
+		)
+
+		switch arg {
+		case "-m", "-k":
+			if memorySpecified {
+				return nil, errors.New("-m or -k can only be used once")
+			}
+			memorySpecified = true
+
+			if n, err = value(); err != nil {
+				break
+			}
+
+			if arg == "-k" {
+				opts.memory = uint32(n)
+			} else if n > 32 {
+				err = fmt.Errorf("bad numeric input for %s", arg)
+			} else if n == 32 {
+				opts.memory = 1<<32 - 1
+			} else {
+				opts.memory = 1 << n
+			}
+		case "-t":
+			n, err = value()
+			opts.time = uint32(n)
+		case "-p":
+			n, err = value()
+			opts.parallelism = uint32(n)
+		case "-l":
+			n, err = value()
+			opts.keyLength = uint32(n)
+		case "-i":
+			opts.variant = argon2.Argon2i
+		case "-d":
+			opts.variant = argon2.Argon2d
+		case "-id":
+			opts.variant = argon2.Argon2id
+		case "-e":
+			opts.encodedOnly = true
+		case "-r":
+			opts.rawOnly = true
+		case "-v":
+			if i+1 >= len(args) {
+				return nil, errors.New("missing -v argument")
+			}
+			i++
+
+			switch args[i] {
+			case "10":
+				opts.version = argon2.V10
+			case "13":
+				opts.version = argon2.V13
+			default:
+				err = errors.New("invalid Argon2 version")
+			}
+		default:
+			err = errors.New("unknown argument")
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.encodedOnly && opts.rawOnly {
+		return nil, errors.New("cannot provide both -e and -r")
+	}
+
+	return opts, nil
+}
+
+// readPassword reads the whole standard input, without stripping newlines.
+func readPassword(stdin io.Reader) ([]byte, error) {
+	password, err := io.ReadAll(io.LimitReader(stdin, maxPasswordLength))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(password) < 1 {
+		return nil, errors.New("no password read")
+	}
+	if len(password) == maxPasswordLength {
+		return nil, errors.New("Provided password longer than supported in command line utility")
+	}
+
+	return password, nil
+}
+
+func hash(stdout io.Writer, opts *options, password []byte) error {
+	if !opts.encodedOnly && !opts.rawOnly {
+		fmt.Fprintf(stdout, "Type:\t\t%s\n", variantName(opts.variant))
+		fmt.Fprintf(stdout, "Iterations:\t%d\n", opts.time)
+		fmt.Fprintf(stdout, "Memory:\t\t%d KiB\n", opts.memory)
+		fmt.Fprintf(stdout, "Parallelism:\t%d\n", opts.parallelism)
+	}
+
+	start := time.Now()
+	key, err := argon2.Key(password, opts.salt, opts.time, opts.parallelism, opts.memory,
+		int(opts.keyLength), opts.variant, opts.version)
+	if err != nil {
+		return err
+	}
+	elapsed := time.Since(start)
+
+	encoded := (&argon2.Hash{
+		Variant: opts.variant,
+		Version: opts.version,
+		Memory:  opts.memory,
+		Time:    opts.time,
+		Lanes:   opts.parallelism,
+		Salt:    opts.salt,
+		Key:     key,
+	}).String()
+
+	switch {
+	case opts.encodedOnly:
+		fmt.Fprintln(stdout, encoded)
+		return nil
+	case opts.rawOnly:
+		fmt.Fprintln(stdout, hex.EncodeToString(key))
+		return nil
+	}
+
+	fmt.Fprintf(stdout, "Hash:\t\t%s\n", hex.EncodeToString(key))
+	fmt.Fprintf(stdout, "Encoded:\t%s\n", encoded)
+	fmt.Fprintf(stdout, "%2.3f seconds\n", elapsed.Seconds())
+
+	if err := argon2.CompareHashAndPassword(encoded, password); err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, "Verification ok")
+
+	return nil
+}
+
+// variantName returns the capitalized name printed by the reference.
+func variantName(v argon2.Variant) string {
+	name := v.String()
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// fatal prints the error like the reference does and returns its exit code.
+// The messages of the library are printed without their prefix and without
+// the bounds of the parameter errors.
+func fatal(stderr io.Writer, err error) int {
+	var paramErr *argon2.ParamError
+	if errors.As(err, &paramErr) {
+		err = paramErr.Err
+	}
+
+	fmt.Fprintf(stderr, "Error: %s\n", strings.TrimPrefix(err.Error(), "argon2: "))
+	return 1
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/pzduniak/argon2"
+)
+
+// The timing line is the only one that differs from run to run
+var secondsLine = regexp.MustCompile(`(?m)^\d+\.\d{3} seconds$`)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args   string
+		stdin  string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{
+			// The example of the README of the reference implementation
+			args:  "somesalt -t 2 -m 16 -p 4 -l 24",
+			stdin: "password",
+			stdout: "Type:\t\tArgon2i\n" +
+				"Iterations:\t2\n" +
+				"Memory:\t\t65536 KiB\n" +
+				"Parallelism:\t4\n" +
+				"Hash:\t\t45d7ac72e76f242b20b77b9bf9bf9d5915894e669a24e6c6\n" +
+				"Encoded:\t$argon2i$v=19$m=65536,t=2,p=4$c29tZXNhbHQ$RdescudvJCsgt3ub+b+dWRWJTmaaJObG\n" +
+				"0.000 seconds\n" +
+				"Verification ok\n",
+		},
+		{
+			args:   "somesalt -id -t 2 -k 256 -e",
+			stdin:  "password",
+			stdout: "$argon2id$v=19$m=256,t=2,p=1$c29tZXNhbHQ$nf65EOgLrQMR/uIPnA4rEsF5h7TKyQwu9U1bMCHGi/4\n",
+		},
+		{
+			args:   "somesalt -t 2 -m 8 -v 10 -r",
+			stdin:  "password",
+			stdout: "fd4dd83d762c49bdeaf57c47bdcd0c2f1babf863fdeb490df63ede9975fccf06\n",
+		},
+		{
+			args:   "somesalt -e -r",
+			stdin:  "password",
+			code:   1,
+			stderr: "Error: cannot provide both -e and -r\n",
+		},
+		{
+			args:   "somesalt -m 12 -k 4096",
+			stdin:  "password",
+			code:   1,
+			stderr: "Error: -m or -k can only be used once\n",
+		},
+		{
+			args:   "somesalt -t 0",
+			stdin:  "password",
+			code:   1,
+			stderr: "Error: bad numeric input for -t\n",
+		},
+		{
+			args:   "somesalt -v 12",
+			stdin:  "password",
+			code:   1,
+			stderr: "Error: invalid Argon2 version\n",
+		},
+		{
+			args:   "somesalt",
+			code:   1,
+			stderr: "Error: no password read\n",
+		},
+		{
+			// The password is read before the options are parsed
+			args:   "somesalt -e -r",
+			code:   1,
+			stderr: "Error: no password read\n",
+		},
+		{
+			args:  "salt",
+			stdin: "password",
+			code:  1,
+			stdout: "Type:\t\tArgon2i\n" +
+				"Iterations:\t3\n" +
+				"Memory:\t\t4096 KiB\n" +
+				"Parallelism:\t1\n",
+			stderr: "Error: Salt is too short\n",
+		},
+	}
+
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+
+		code := run(append([]string{"argon2"}, strings.Fields(tt.args)...), strings.NewReader(tt.stdin), &stdout, &stderr)
+		if code != tt.code {
+			t.Errorf("%s: exit code %d, want %d", tt.args, code, tt.code)
+		}
+
+		if got := secondsLine.ReplaceAllString(stdout.String(), "0.000 seconds"); got != tt.stdout {
+			t.Errorf("%s: stdout\n%s\nwant\n%s", tt.args, got, tt.stdout)
+		}
+		if got := stderr.String(); got != tt.stderr {
+			t.Errorf("%s: stderr %q, want %q", tt.args, got, tt.stderr)
+		}
+	}
+}
+
+func TestUsage(t *testing.T) {
+	for _, tt := range []struct {
+		args []string
+		code int
+	}{
+		{nil, exitMissingArgs},
+		{[]string{"-h"}, 1},
+		{[]string{"-h", "somesalt"}, 1},
+	} {
+		var stdout, stderr bytes.Buffer
+
+		code := run(append([]string{"argon2"}, tt.args...), strings.NewReader("password"), &stdout, &stderr)
+		if code != tt.code {
+			t.Errorf("%q: exit code %d, want %d", tt.args, code, tt.code)
+		}
+
+		if !strings.HasPrefix(stdout.String(), "Usage:  argon2 [-h] salt") || stderr.Len() != 0 {
+			t.Errorf("%q: stdout %q, stderr %q, want the usage", tt.args, stdout.String(), stderr.String())
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	const encoded = "$argon2id$v=19$m=256,t=2,p=1$c29tZXNhbHQ$nf65EOgLrQMR/uIPnA4rEsF5h7TKyQwu9U1bMCHGi/4"
+
+	tests := []struct {
+		args   []string
+		stdin  string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{
+			args:   []string{encoded},
+			stdin:  "password",
+			code:   exitMatch,
+			stdout: "Verification ok\n",
+		},
+		{
+			args:   nil,
+			stdin:  encoded + "\npassword",
+			code:   exitMatch,
+			stdout: "Verification ok\n",
+		},
+		{
+			args:   []string{encoded},
+			stdin:  "password\n",
+			code:   exitMismatch,
+			stderr: "Error: The password does not match the supplied hash\n",
+		},
+		{
+			args:   []string{"$argon2id$v=19$m=256,t=2,p=1$c29tZXNhbHQ"},
+			stdin:  "password",
+			code:   exitMalformed,
+			stderr: "Error: Encoded hash has an invalid format (field hash)\n",
+		},
+		{
+			args:   []string{"$argon2id$v=19$m=256,t=2,p=0$c29tZXNhbHQ$nf65EOgLrQMR/uIPnA4rEsF5h7TKyQwu9U1bMCHGi/4"},
+			stdin:  "password",
+			code:   exitMalformed,
+			stderr: "Error: Too few lanes\n",
+		},
+		{
+			args:   []string{"$argon2id$v=19$m=256,t=2,p=1,keyid=aWQ$c29tZXNhbHQ$nf65EOgLrQMR/uIPnA4rEsF5h7TKyQwu9U1bMCHGi/4"},
+			stdin:  "password",
+			code:   1,
+			stderr: "Error: Encoded hash refers to a secret by its keyid, it can not be verified\n",
+		},
+		{
+			args:   []string{encoded, encoded},
+			code:   1,
+			stderr: "Error: unknown argument\n",
+		},
+	}
+
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+
+		code := run(append([]string{"argon2", "verify"}, tt.args...), strings.NewReader(tt.stdin), &stdout, &stderr)
+		if code != tt.code {
+			t.Errorf("%q: exit code %d, want %d", tt.args, code, tt.code)
+		}
+
+		if got := stdout.String(); got != tt.stdout {
+			t.Errorf("%q: stdout %q, want %q", tt.args, got, tt.stdout)
+		}
+		if got := stderr.String(); got != tt.stderr {
+			t.Errorf("%q: stderr %q, want %q", tt.args, got, tt.stderr)
+		}
+	}
+}
+
+func TestVerifyAssociatedData(t *testing.T) {
+	encoded, err := argon2.GenerateFromPassword([]byte("password"), argon2.Params{
+		Time:           2,
+		Memory:         256,
+		Lanes:          1,
+		Variant:        argon2.Argon2id,
+		AssociatedData: []byte("user@example.com"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		password string
+		code     int
+	}{
+		{"password", exitMatch},
+		{"Password", exitMismatch},
+	} {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"argon2", "verify", encoded}, strings.NewReader(tt.password), &stdout, &stderr)
+		if code != tt.code {
+			t.Errorf("%s, %q: exit code %d, want %d (%s)", encoded, tt.password, code, tt.code, stderr.String())
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pzduniak/argon2"
+	"golang.org/x/term"
+)
+
+// Exit codes of the verify mode, failures unrelated to the hash exit with 1
+// like the rest of the utility
+const (
+	exitMatch     = 0
+	exitMismatch  = 2
+	exitMalformed = 3
+)
+
+// verify checks a password against an encoded hash, given as the argument
+// or on the first line of the standard input. The password is prompted for
+// without echo if the standard input is a terminal, otherwise it is the rest
+// of the standard input, read like in the hash mode.
+func verify(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) > 1 {
+		return fatal(stderr, errors.New("unknown argument"))
+	}
+
+	/* 1. Read the encoded hash */
+	var (
+		encoded string
+		reader  = bufio.NewReader(stdin)
+	)
+	if len(args) == 1 {
+		encoded = args[0]
+	} else {
+		if isTerminal(stdin) {
+			fmt.Fprint(stderr, "Encoded: ")
+		}
+
+		line, err := reader.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return fatal(stderr, errors.New("no encoded hash read"))
+		}
+		encoded = strings.TrimRight(line, "\r\n")
+	}
+
+	hash, err := argon2.ParseHash(encoded)
+	if err != nil {
+		fatal(stderr, err)
+		return exitMalformed
+	}
+
+	// Well formed, but the secret it refers to is not available here
+	if hash.KeyID != nil {
+		return fatal(stderr, argon2.ErrKeyIDNotSupported)
+	}
+
+	/* 2. Read the password */
+	var password []byte
+	if isTerminal(stdin) {
+		fmt.Fprint(stderr, "Password: ")
+		password, err = term.ReadPassword(int(stdin.(*os.File).Fd()))
+		fmt.Fprintln(stderr)
+	} else {
+		password, err = readPassword(reader)
+	}
+	if err != nil {
+		return fatal(stderr, err)
+	}
+
+	/* 3. Compare, with the parameters and the associated data of the hash */
+	switch err := argon2.CompareHashAndPassword(encoded, password); {
+	case err == argon2.ErrMismatchedHashAndPassword:
+		fatal(stderr, errors.New("The password does not match the supplied hash"))
+		return exitMismatch
+	case errors.As(err, new(*argon2.ParamError)), errors.As(err, new(*argon2.ParseError)):
+		// The hash carries parameters out of their bounds
+		fatal(stderr, err)
+		return exitMalformed
+	case err != nil:
+		return fatal(stderr, err)
+	}
+
+	fmt.Fprintln(stdout, "Verification ok")
+	return exitMatch
+}
+
+func isTerminal(stdin io.Reader) bool {
+	f, ok := stdin.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
@@ -0,0 +1,129 @@
+package argon2
+
+import (
+	"math"
+	"math/bits"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Number of blocks compressed by a single calibration run of round, 8 MiB
+const roundCalibrationBlocks = 8192
+
+// Nanoseconds taken by a single call of round on this host, measured once
+var roundCalibration struct {
+	once     sync.Once
+	duration float64
+}
+
+// Cost is the cost of a derivation, as estimated by EstimateCost.
+type Cost struct {
+	MemoryBlocks uint32        // Number of 1 KiB blocks actually allocated
+	Memory       uint64        // Size of the memory matrix in bytes
+	Compressions uint64        // Calls of the compression function
+	Goroutines   uint32        // Goroutines filling the memory
+	Duration     time.Duration // Estimated duration on this host
+}
+
+// EstimateCost computes the cost of a derivation with the passed parameters
+// without running it. The memory, the number of compressions and the number
+// of goroutines are exact, the duration is extrapolated from the throughput
+// of the compression function, measured once on the first call. It ignores
+// the allocation of the memory and the initial and final hashing, so it is a
+// lower bound. The password and the salt may be left empty, the other
+// parameters are validated like Derive does. It returns ErrSelfTestFailed if
+// the self-test has failed, the duration would be meaningless then.
+func EstimateCost(params Params) (Cost, error) {
+	if err := checkSelfTest(); err != nil {
+		return Cost{}, err
+	}
+
+	if errs := ValidateParams(params); len(errs) != 0 {
+		return Cost{}, errs[0]
+	}
+
+	/* 1. Align memory size like core does */
+	memoryBlocks, segmentLength := alignMemory(params.Memory, params.Lanes)
+
+	/* 2. Never more threads than lanes, like fillMemoryBlocks */
+	threads := params.Threads
+	if threads == 0 || threads > params.Lanes {
+		threads = params.Lanes
+	}
+
+	/* 3. Every block is compressed once per pass, except for the first
+	   two blocks of every lane, hashed by fillFirstBlocks */
+	compressions := uint64(params.Time)*uint64(memoryBlocks) - 2*uint64(params.Lanes)
+
+	/* 4. Every segment using data-independent addressing generates a block
+	   of addresses for every addressesInBlock blocks, with two
+	   compressions each */
+	var segments uint64
+	switch params.Variant {
+	case Argon2i:
+		segments = uint64(params.Time) * syncPoints * uint64(params.Lanes)
+	case Argon2id:
+		segments = syncPoints / 2 * uint64(params.Lanes)
+	}
+
+	addresses := 2 * uint64((segmentLength+addressesInBlock-1)/addressesInBlock)
+	hi, addressing := bits.Mul64(segments, addresses)
+	compressions, carry := bits.Add64(compressions, addressing, 0)
+	if hi != 0 || carry != 0 {
+		compressions = math.MaxUint64
+	}
+
+	/* 5. The lanes are filled in parallel, a goroutine fills every
+	   threads-th lane, but no more goroutines run at once than GOMAXPROCS */
+	parallel := threads
+	if procs := uint32(runtime.GOMAXPROCS(0)); parallel > procs {
+		parallel = procs
+	}
+
+	perLane := float64(compressions) / float64(params.Lanes)
+	lanesPerThread := float64((params.Lanes + parallel - 1) / parallel)
+
+	duration := time.Duration(math.MaxInt64)
+	if d := perLane * lanesPerThread * roundDuration(); d < math.MaxInt64 {
+		duration = time.Duration(d)
+	}
+
+	return Cost{
+		MemoryBlocks: memoryBlocks,
+		Memory:       uint64(memoryBlocks) * blockSize,
+		Compressions: compressions,
+		Goroutines:   threads,
+		Duration:     duration,
+	}, nil
+}
+
+// roundDuration returns the nanoseconds taken by a single call of round,
+// calibrating it on the first call. Like in a derivation, every call reads
+// the previous block and a pseudo-random one from a matrix larger than the
+// caches. The fastest of three runs is kept.
+func roundDuration() float64 {
+	roundCalibration.once.Do(func() {
+		memory := make([]block, roundCalibrationBlocks)
+		for i := range memory {
+			memory[i][0] = uint64(i) * 0x9E3779B97F4A7C15
+		}
+
+		best := time.Duration(math.MaxInt64)
+		for run := 0; run < 3; run++ {
+			start := time.Now()
+			for i := 1; i < roundCalibrationBlocks; i++ {
+				ref := memory[i-1][0] % uint64(i)
+				round(&memory[i], &memory[ref], &memory[i-1])
+			}
+
+			if elapsed := time.Since(start); elapsed < best {
+				best = elapsed
+			}
+		}
+
+		roundCalibration.duration = float64(best) / (roundCalibrationBlocks - 1)
+	})
+
+	return roundCalibration.duration
+}
@@ -0,0 +1,71 @@
+package argon2
+
+import "testing"
+
+func TestEstimateCost(t *testing.T) {
+	for _, variant := range []Variant{Argon2d, Argon2i, Argon2id} {
+		for _, p := range []struct{ time, memory, lanes, threads uint32 }{
+			{1, 8, 1, 0},
+			{3, 64, 1, 0},
+			{2, 100, 4, 0},
+			{1, 1000, 3, 2},
+			{2, 2048, 2, 8},
+		} {
+			params := Params{
+				Time:      p.time,
+				Memory:    p.memory,
+				Lanes:     p.lanes,
+				Threads:   p.threads,
+				KeyLength: 32,
+				Variant:   variant,
+			}
+
+			cost, err := EstimateCost(params)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			blocks, segmentLength := alignMemory(params.Memory, params.Lanes)
+			goroutines := params.Threads
+			if goroutines == 0 || goroutines > params.Lanes {
+				goroutines = params.Lanes
+			}
+
+			// Every block of every pass but the first two of every lane,
+			// plus two per block of addresses of the data-independent
+			// segments: all of them for Argon2i, the first half of the
+			// first pass for Argon2id
+			compressions := uint64(params.Time)*uint64(blocks) - 2*uint64(params.Lanes)
+
+			var segments uint64
+			switch variant {
+			case Argon2i:
+				segments = uint64(params.Time) * syncPoints * uint64(params.Lanes)
+			case Argon2id:
+				segments = syncPoints / 2 * uint64(params.Lanes)
+			}
+			addressBlocks := uint64(segmentLength+addressesInBlock-1) / addressesInBlock
+			compressions += segments * 2 * addressBlocks
+
+			want := Cost{
+				MemoryBlocks: blocks,
+				Memory:       uint64(blocks) * blockSize,
+				Compressions: compressions,
+				Goroutines:   goroutines,
+				Duration:     cost.Duration,
+			}
+			if cost != want {
+				t.Errorf("%s %+v: %+v, want %+v", variant, p, cost, want)
+			}
+		}
+	}
+
+	// 8 blocks of a single lane, a block of addresses per segment
+	cost, err := EstimateCost(Params{Time: 1, Memory: 8, Lanes: 1, KeyLength: 32, Variant: Argon2i})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost.Compressions != 6+4*2 {
+		t.Errorf("%d compressions, want 14", cost.Compressions)
+	}
+}
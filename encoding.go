@@ -0,0 +1,224 @@
+package argon2
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+)
+
+// b64 is the encoding used by the PHC string format: standard alphabet, no
+// padding and no stray bits.
+var b64 = base64.RawStdEncoding.Strict()
+
+// Hash is a derived key together with everything needed to recompute it,
+// serialized in the PHC string format:
+//
+//	$argon2id$v=19$m=65536,t=3,p=4[,keyid=...][,data=...]$<salt>$<key>
+type Hash struct {
+	Variant Variant
+	Version Version
+	Memory  uint32
+	Time    uint32
+	Lanes   uint32
+	KeyID   []byte // Optional key identifier
+	Data    []byte // Optional associated data
+	Salt    []byte
+	Key     []byte
+}
+
+// String returns the name of the variant as used in the encoded hashes.
+func (v Variant) String() string {
+	switch v {
+	case Argon2d:
+		return "argon2d"
+	case Argon2i:
+		return "argon2i"
+	case Argon2id:
+		return "argon2id"
+	}
+
+	return "argon2(" + strconv.Itoa(int(v)) + ")"
+}
+
+// String encodes the hash in the PHC string format. The version is always
+// included, a zero one is written as DefaultVersion.
+func (h *Hash) String() string {
+	version := h.Version
+	if version == 0 {
+		version = DefaultVersion
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("$")
+	sb.WriteString(h.Variant.String())
+	sb.WriteString("$v=")
+	sb.WriteString(strconv.FormatUint(uint64(version), 10))
+	sb.WriteString("$m=")
+	sb.WriteString(strconv.FormatUint(uint64(h.Memory), 10))
+	sb.WriteString(",t=")
+	sb.WriteString(strconv.FormatUint(uint64(h.Time), 10))
+	sb.WriteString(",p=")
+	sb.WriteString(strconv.FormatUint(uint64(h.Lanes), 10))
+	if h.KeyID != nil {
+		sb.WriteString(",keyid=")
+		sb.WriteString(b64.EncodeToString(h.KeyID))
+	}
+	if h.Data != nil {
+		sb.WriteString(",data=")
+		sb.WriteString(b64.EncodeToString(h.Data))
+	}
+	sb.WriteString("$")
+	sb.WriteString(b64.EncodeToString(h.Salt))
+	sb.WriteString("$")
+	sb.WriteString(b64.EncodeToString(h.Key))
+
+	return sb.String()
+}
+
+// ParseHash decodes a hash in the PHC string format. A missing version field
+// means V10. All errors are of type *ParseError.
+func ParseHash(encoded string) (*Hash, error) {
+	fields := strings.Split(encoded, "$")
+	if len(fields) < 5 || fields[0] != "" {
+		return nil, &ParseError{Field: "hash", Err: ErrHashFormat}
+	}
+	fields = fields[1:]
+
+	h := &Hash{
+		Version: V10,
+	}
+
+	/* 1. Variant */
+	switch fields[0] {
+	case "argon2d":
+		h.Variant = Argon2d
+	case "argon2i":
+		h.Variant = Argon2i
+	case "argon2id":
+		h.Variant = Argon2id
+	default:
+		return nil, &ParseError{Field: "variant", Err: ErrIncorrectType}
+	}
+	fields = fields[1:]
+
+	/* 2. Optional version */
+	if strings.HasPrefix(fields[0], "v=") {
+		version, ok := parseDecimal(fields[0][2:])
+		if !ok {
+			return nil, &ParseError{Field: "v", Err: ErrHashParameter}
+		}
+
+		h.Version = Version(version)
+		if h.Version != V10 && h.Version != V13 {
+			return nil, &ParseError{Field: "v", Err: ErrIncorrectVersion}
+		}
+		fields = fields[1:]
+	}
+
+	if len(fields) != 3 {
+		return nil, &ParseError{Field: "hash", Err: ErrHashFormat}
+	}
+
+	/* 3. Parameters, m, t and p are mandatory and in this exact order */
+	params := strings.Split(fields[0], ",")
+	if len(params) < 3 {
+		return nil, &ParseError{Field: "params", Err: ErrHashFormat}
+	}
+
+	for i, name := range []string{"m", "t", "p"} {
+		value, ok := cutParam(params[i], name)
+		if !ok {
+			return nil, &ParseError{Field: name, Err: ErrHashFormat}
+		}
+
+		number, ok := parseDecimal(value)
+		if !ok {
+			return nil, &ParseError{Field: name, Err: ErrHashParameter}
+		}
+
+		switch name {
+		case "m":
+			h.Memory = number
+		case "t":
+			h.Time = number
+		case "p":
+			h.Lanes = number
+		}
+	}
+
+	params = params[3:]
+	for _, name := range []string{"keyid", "data"} {
+		if len(params) == 0 {
+			break
+		}
+
+		value, ok := cutParam(params[0], name)
+		if !ok {
+			continue
+		}
+
+		decoded, err := b64.DecodeString(value)
+		if err != nil {
+			return nil, &ParseError{Field: name, Err: ErrHashEncoding}
+		}
+
+		if name == "keyid" {
+			h.KeyID = decoded
+		} else {
+			h.Data = decoded
+		}
+		params = params[1:]
+	}
+
+	if len(params) != 0 {
+		return nil, &ParseError{Field: "params", Err: ErrHashFormat}
+	}
+
+	/* 4. Salt and the key itself */
+	var err error
+	if h.Salt, err = b64.DecodeString(fields[1]); err != nil {
+		return nil, &ParseError{Field: "salt", Err: ErrHashEncoding}
+	}
+	if len(h.Salt) == 0 {
+		return nil, &ParseError{Field: "salt", Err: ErrHashFormat}
+	}
+
+	if h.Key, err = b64.DecodeString(fields[2]); err != nil {
+		return nil, &ParseError{Field: "key", Err: ErrHashEncoding}
+	}
+	if len(h.Key) == 0 {
+		return nil, &ParseError{Field: "key", Err: ErrHashFormat}
+	}
+
+	return h, nil
+}
+
+// cutParam returns the value of a name=value pair if the name matches.
+func cutParam(param, name string) (string, bool) {
+	if !strings.HasPrefix(param, name+"=") {
+		return "", false
+	}
+
+	return param[len(name)+1:], true
+}
+
+// parseDecimal parses a 32-bit decimal number without a sign or leading zeros.
+func parseDecimal(value string) (uint32, bool) {
+	if value == "" || (len(value) > 1 && value[0] == '0') {
+		return 0, false
+	}
+
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return 0, false
+		}
+	}
+
+	number, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return uint32(number), true
+}
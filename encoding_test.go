@@ -0,0 +1,144 @@
+package argon2
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestHashRoundTrip(t *testing.T) {
+	for _, variant := range []Variant{Argon2d, Argon2i, Argon2id} {
+		for _, version := range []Version{V10, V13} {
+			for _, keyID := range [][]byte{nil, []byte("key-1")} {
+				for _, data := range [][]byte{nil, []byte("user@example.com")} {
+					h := &Hash{
+						Variant: variant,
+						Version: version,
+						Memory:  65536,
+						Time:    3,
+						Lanes:   4,
+						KeyID:   keyID,
+						Data:    data,
+						Salt:    []byte("somesalt"),
+						Key:     []byte("0123456789abcdef0123456789abcdef"),
+					}
+
+					encoded := h.String()
+					parsed, err := ParseHash(encoded)
+					if err != nil {
+						t.Fatalf("%s: %v", encoded, err)
+					}
+
+					if !reflect.DeepEqual(parsed, h) {
+						t.Errorf("%s: parsed as %+v", encoded, parsed)
+					}
+					if parsed.String() != encoded {
+						t.Errorf("%s: encoded again as %s", encoded, parsed.String())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestParseHash(t *testing.T) {
+	tests := []struct {
+		encoded string
+		hash    Hash
+	}{
+		{
+			encoded: "$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5",
+			hash:    Hash{Variant: Argon2id, Version: V13, Memory: 65536, Time: 3, Lanes: 4, Salt: []byte("somesalt"), Key: []byte("key")},
+		},
+		{
+			// A missing version is the version of the hashes predating it
+			encoded: "$argon2i$m=4096,t=3,p=1$c29tZXNhbHQ$a2V5",
+			hash:    Hash{Variant: Argon2i, Version: V10, Memory: 4096, Time: 3, Lanes: 1, Salt: []byte("somesalt"), Key: []byte("key")},
+		},
+		{
+			encoded: "$argon2d$v=16$m=32,t=1,p=2,keyid=aWQ,data=ZGF0YQ$c29tZXNhbHQ$a2V5",
+			hash: Hash{Variant: Argon2d, Version: V10, Memory: 32, Time: 1, Lanes: 2, KeyID: []byte("id"),
+				Data: []byte("data"), Salt: []byte("somesalt"), Key: []byte("key")},
+		},
+		{
+			encoded: "$argon2id$v=19$m=65536,t=3,p=4,data=ZGF0YQ$c29tZXNhbHQ$a2V5",
+			hash: Hash{Variant: Argon2id, Version: V13, Memory: 65536, Time: 3, Lanes: 4,
+				Data: []byte("data"), Salt: []byte("somesalt"), Key: []byte("key")},
+		},
+	}
+
+	for _, tt := range tests {
+		h, err := ParseHash(tt.encoded)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.encoded, err)
+		}
+
+		if !reflect.DeepEqual(*h, tt.hash) {
+			t.Errorf("%s: parsed as %+v, want %+v", tt.encoded, *h, tt.hash)
+		}
+	}
+}
+
+func TestParseHashErrors(t *testing.T) {
+	tests := []struct {
+		encoded string
+		field   string
+		err     error
+	}{
+		{"", "hash", ErrHashFormat},
+		{"argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5", "hash", ErrHashFormat},
+		{"$argon2x$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5", "variant", ErrIncorrectType},
+		{"$Argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5", "variant", ErrIncorrectType},
+		{"$argon2id$v=18$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5", "v", ErrIncorrectVersion},
+		{"$argon2id$v=019$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5", "v", ErrHashParameter},
+		{"$argon2id$v=$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5", "v", ErrHashParameter},
+
+		// Extra or missing $ fields
+		{"$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5$", "hash", ErrHashFormat},
+		{"$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5$a2V5", "hash", ErrHashFormat},
+		{"$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ", "hash", ErrHashFormat},
+		{"$argon2id$v=19$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5", "hash", ErrHashFormat},
+
+		// Order of the parameters
+		{"$argon2id$v=19$t=3,m=65536,p=4$c29tZXNhbHQ$a2V5", "m", ErrHashFormat},
+		{"$argon2id$v=19$m=65536,p=4,t=3$c29tZXNhbHQ$a2V5", "t", ErrHashFormat},
+		{"$argon2id$v=19$m=65536,t=3$c29tZXNhbHQ$a2V5", "params", ErrHashFormat},
+		{"$argon2id$v=19$m=65536,t=3,p=4,data=ZGF0YQ,keyid=aWQ$c29tZXNhbHQ$a2V5", "params", ErrHashFormat},
+		{"$argon2id$v=19$m=65536,t=3,p=4,foo=bar$c29tZXNhbHQ$a2V5", "params", ErrHashFormat},
+		{"$argon2id$v=19$m=65536,t=3,p=4,$c29tZXNhbHQ$a2V5", "params", ErrHashFormat},
+
+		// Numbers
+		{"$argon2id$v=19$m=065536,t=3,p=4$c29tZXNhbHQ$a2V5", "m", ErrHashParameter},
+		{"$argon2id$v=19$m=65536,t=03,p=4$c29tZXNhbHQ$a2V5", "t", ErrHashParameter},
+		{"$argon2id$v=19$m=65536,t=3,p=+4$c29tZXNhbHQ$a2V5", "p", ErrHashParameter},
+		{"$argon2id$v=19$m=4294967296,t=3,p=4$c29tZXNhbHQ$a2V5", "m", ErrHashParameter},
+		{"$argon2id$v=19$m=,t=3,p=4$c29tZXNhbHQ$a2V5", "m", ErrHashParameter},
+
+		// Base64, padded, non-canonical trailing bits or empty
+		{"$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ=$a2V5", "salt", ErrHashEncoding},
+		{"$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHR$a2V5", "salt", ErrHashEncoding},
+		{"$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$a2V", "key", ErrHashEncoding},
+		{"$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$a2V5a2U=", "key", ErrHashEncoding},
+		{"$argon2id$v=19$m=65536,t=3,p=4,keyid=aW=$c29tZXNhbHQ$a2V5", "keyid", ErrHashEncoding},
+		{"$argon2id$v=19$m=65536,t=3,p=4,data=ZGF0YR$c29tZXNhbHQ$a2V5", "data", ErrHashEncoding},
+		{"$argon2id$v=19$m=65536,t=3,p=4$$a2V5", "salt", ErrHashFormat},
+		{"$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$", "key", ErrHashFormat},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseHash(tt.encoded)
+
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("%q: %v, want a *ParseError", tt.encoded, err)
+			continue
+		}
+
+		if parseErr.Field != tt.field {
+			t.Errorf("%q: field %q, want %q", tt.encoded, parseErr.Field, tt.field)
+		}
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%q: %v, want %v", tt.encoded, err, tt.err)
+		}
+	}
+}
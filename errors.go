@@ -2,11 +2,13 @@ package argon2
 
 import (
 	"errors"
+	"strconv"
 )
 
 // Various errors returned by the library
 var (
-	ErrIncorrectType      = errors.New("argon2: Invalid type passed (must be either Argon2i or Argon2d)")
+	ErrIncorrectType      = errors.New("argon2: Invalid type passed (must be Argon2i, Argon2d or Argon2id)")
+	ErrIncorrectVersion   = errors.New("argon2: Invalid version passed (must be either V10 or V13)")
 	ErrIncorrectParameter = errors.New("argon2: Incorrect parameter passed to the argon function")
 	ErrOutputPtrNull      = errors.New("argon2: Output must be an allocated slice")
 	ErrOutputTooShort     = errors.New("argon2: Output is too short")
@@ -28,4 +30,52 @@ var (
 	ErrThreadsTooFew      = errors.New("argon2: Too few threads")
 	ErrThreadsTooMany     = errors.New("argon2: Too many threads")
 	ErrThreadFail         = errors.New("argon2: Thread failed")
+	ErrHashFormat         = errors.New("argon2: Encoded hash has an invalid format")
+	ErrHashParameter      = errors.New("argon2: Encoded hash has an invalid parameter value")
+	ErrHashEncoding       = errors.New("argon2: Encoded hash contains invalid base64")
+
+	ErrMismatchedHashAndPassword = errors.New("argon2: Hashed password is not the hash of the given password")
+	ErrSecretNotEncodable        = errors.New("argon2: Secret can not be stored in an encoded hash")
+	ErrKeyIDNotSupported         = errors.New("argon2: Encoded hash refers to a secret by its keyid, it can not be verified")
+	ErrTargetTooShort            = errors.New("argon2: Target duration is too short for the minimum memory cost")
+	ErrMemoryBudgetExceeded      = errors.New("argon2: Memory budget of the governor exceeded")
+	ErrSelfTestFailed            = errors.New("argon2: Self-test failed, the implementation is broken on this host")
 )
+
+// ParseError is returned by ParseHash. It records the field of the encoded
+// hash that could not be parsed.
+type ParseError struct {
+	Field string
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return e.Err.Error() + " (field " + e.Field + ")"
+}
+
+// Unwrap returns the underlying error, so that errors.Is works with the
+// sentinel errors above.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParamError is returned for the parameters outside of their bounds. Err is
+// one of the sentinel errors above, so errors.Is(err, ErrMemoryTooLittle)
+// keeps working. Lengths of the byte slices are reported as their values.
+type ParamError struct {
+	Field string // Name of the field of Params
+	Value uint64
+	Min   uint64
+	Max   uint64
+	Err   error
+}
+
+func (e *ParamError) Error() string {
+	return e.Err.Error() + " (" + e.Field + " is " + strconv.FormatUint(e.Value, 10) +
+		", must be between " + strconv.FormatUint(e.Min, 10) + " and " + strconv.FormatUint(e.Max, 10) + ")"
+}
+
+// Unwrap returns the sentinel error.
+func (e *ParamError) Unwrap() error {
+	return e.Err
+}
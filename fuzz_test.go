@@ -0,0 +1,51 @@
+package argon2_test
+
+import (
+	"bytes"
+	"testing"
+
+	conv "github.com/pzduniak/argon2"
+	xargon2 "golang.org/x/crypto/argon2"
+)
+
+// FuzzKey compares Key with golang.org/x/crypto/argon2, which only supports
+// Argon2i and Argon2id version 1.3 without a secret or associated data. The
+// costs are mapped to the bounds accepted by both packages and kept small,
+// so that the fuzzer runs many derivations per second. The inputs of every
+// mismatch are stored in testdata/fuzz/FuzzKey and run by go test.
+func FuzzKey(f *testing.F) {
+	f.Add([]byte("password"), []byte("somesalt"), uint32(1), uint32(0), uint8(0), uint16(28), false)
+	f.Add([]byte("password"), []byte("somesalt"), uint32(2), uint32(248), uint8(1), uint16(28), true)
+	f.Add([]byte(""), []byte("saltsaltsaltsalt"), uint32(0), uint32(1000), uint8(3), uint16(60), true)
+	f.Add(bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 16), uint32(2), uint32(0), uint8(3), uint16(120), false)
+
+	f.Fuzz(func(t *testing.T, password, salt []byte, time, memory uint32, parallelism uint8, keyLength uint16, id bool) {
+		if len(salt) < 8 {
+			t.Skip("x/crypto accepts salts shorter than 8 bytes")
+		}
+
+		var (
+			p = uint32(parallelism%8) + 1
+			m = 8*p + memory%1024
+			l = uint32(keyLength%125) + 4
+		)
+		time = time%4 + 1
+
+		variant, want := conv.Argon2i, []byte(nil)
+		if id {
+			variant = conv.Argon2id
+			want = xargon2.IDKey(password, salt, time, m, uint8(p), l)
+		} else {
+			want = xargon2.Key(password, salt, time, m, uint8(p), l)
+		}
+
+		got, err := conv.Key(password, salt, time, p, m, int(l), variant, conv.V13)
+		if err != nil {
+			t.Fatalf("t=%d m=%d p=%d l=%d: %v", time, m, p, l, err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Fatalf("%s t=%d m=%d p=%d l=%d: %x, want %x", variant, time, m, p, l, got, want)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package argon2
+
+import (
+	"context"
+	"math"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// The process-wide governor, consulted by every derivation
+var governor atomic.Pointer[MemoryGovernor]
+
+// MemoryGovernor limits the total size of the memory matrices of concurrent
+// derivations. Every derivation reserves its memory before allocating it
+// and returns it once the matrix is wiped. A derivation that does not fit
+// in the budget fails fast, waits for a limited time or waits until its
+// context is done, depending on the wait duration of the governor. The idle
+// matrices pooled by a Hasher are not part of the budget.
+type MemoryGovernor struct {
+	budget uint64
+	wait   time.Duration
+
+	mu       sync.Mutex
+	reserved uint64
+	waiting  int
+	released chan struct{} // Closed and replaced on every release
+}
+
+// GovernorStats is a snapshot of the state of a MemoryGovernor.
+type GovernorStats struct {
+	Budget   uint64 // Total budget in bytes
+	Reserved uint64 // Bytes reserved by the running derivations
+	Waiting  int    // Number of derivations waiting for memory
+}
+
+// NewMemoryGovernor creates a governor with a budget in bytes. A zero wait
+// fails the derivations that do not fit right away, a positive one waits up
+// to that long and a negative one waits until the context of the derivation
+// is done. A derivation that gives up returns ErrMemoryBudgetExceeded, or
+// the error of its context if the context is done first.
+func NewMemoryGovernor(budget uint64, wait time.Duration) *MemoryGovernor {
+	return &MemoryGovernor{
+		budget:   budget,
+		wait:     wait,
+		released: make(chan struct{}),
+	}
+}
+
+// SetMemoryGovernor installs the process-wide governor used by all the
+// derivations. Nil removes it.
+func SetMemoryGovernor(g *MemoryGovernor) {
+	governor.Store(g)
+}
+
+// MemoryLimitBudget returns the given fraction of the Go runtime memory
+// limit (GOMEMLIMIT or debug.SetMemoryLimit), for use as a budget. It
+// returns 0 if no limit is set.
+func MemoryLimitBudget(fraction float64) uint64 {
+	limit := debug.SetMemoryLimit(-1)
+	if limit <= 0 || limit == math.MaxInt64 {
+		return 0
+	}
+
+	return uint64(float64(limit) * fraction)
+}
+
+// Stats returns the current state of the governor.
+func (g *MemoryGovernor) Stats() GovernorStats {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return GovernorStats{
+		Budget:   g.budget,
+		Reserved: g.reserved,
+		Waiting:  g.waiting,
+	}
+}
+
+// reserve blocks until the bytes fit in the budget, according to the wait
+// policy. cancel may be nil.
+func (g *MemoryGovernor) reserve(cancel context.Context, bytes uint64) error {
+	if bytes > g.budget {
+		return ErrMemoryBudgetExceeded
+	}
+
+	var (
+		timeout <-chan time.Time
+		done    <-chan struct{}
+	)
+	if cancel != nil {
+		done = cancel.Done()
+	}
+
+	g.mu.Lock()
+	for g.reserved+bytes > g.budget {
+		if g.wait == 0 {
+			g.mu.Unlock()
+			return ErrMemoryBudgetExceeded
+		}
+
+		if g.wait > 0 && timeout == nil {
+			timer := time.NewTimer(g.wait)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+
+		released := g.released
+		g.waiting++
+		g.mu.Unlock()
+
+		var err error
+		select {
+		case <-released:
+		case <-timeout:
+			err = ErrMemoryBudgetExceeded
+		case <-done:
+			err = cancel.Err()
+		}
+
+		g.mu.Lock()
+		g.waiting--
+		if err != nil {
+			g.mu.Unlock()
+			return err
+		}
+	}
+
+	g.reserved += bytes
+	g.mu.Unlock()
+
+	return nil
+}
+
+// release returns the bytes to the budget and wakes up the waiters.
+func (g *MemoryGovernor) release(bytes uint64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.reserved -= bytes
+	close(g.released)
+	g.released = make(chan struct{})
+}
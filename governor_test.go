@@ -0,0 +1,139 @@
+package argon2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// waitForWaiters polls the governor until n derivations wait for memory.
+func waitForWaiters(t *testing.T, g *MemoryGovernor, n int) {
+	t.Helper()
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if g.Stats().Waiting == n {
+			return
+		}
+	}
+	t.Fatalf("%d waiting derivations, want %d", g.Stats().Waiting, n)
+}
+
+func TestMemoryGovernorFailFast(t *testing.T) {
+	g := NewMemoryGovernor(1000, 0)
+
+	if err := g.reserve(nil, 600); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.reserve(nil, 600); err != ErrMemoryBudgetExceeded {
+		t.Errorf("over the budget: %v, want ErrMemoryBudgetExceeded", err)
+	}
+	if err := g.reserve(nil, 2000); err != ErrMemoryBudgetExceeded {
+		t.Errorf("larger than the budget: %v, want ErrMemoryBudgetExceeded", err)
+	}
+
+	if stats := g.Stats(); stats != (GovernorStats{Budget: 1000, Reserved: 600}) {
+		t.Errorf("stats %+v", stats)
+	}
+
+	g.release(600)
+	if err := g.reserve(nil, 600); err != nil {
+		t.Errorf("after the release: %v", err)
+	}
+}
+
+func TestMemoryGovernorTimeout(t *testing.T) {
+	const wait = 20 * time.Millisecond
+
+	g := NewMemoryGovernor(1000, wait)
+	if err := g.reserve(nil, 600); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if err := g.reserve(nil, 600); err != ErrMemoryBudgetExceeded {
+		t.Errorf("%v, want ErrMemoryBudgetExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed < wait {
+		t.Errorf("gave up after %v, want at least %v", elapsed, wait)
+	}
+
+	// A release within the wait lets the derivation through
+	errc := make(chan error)
+	go func() { errc <- g.reserve(nil, 600) }()
+
+	waitForWaiters(t, g, 1)
+	g.release(600)
+
+	if err := <-errc; err != nil {
+		t.Errorf("after the release: %v", err)
+	}
+}
+
+func TestMemoryGovernorWait(t *testing.T) {
+	g := NewMemoryGovernor(1000, -1)
+	if err := g.reserve(nil, 600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errc := make(chan error)
+	go func() { errc <- g.reserve(ctx, 600) }()
+	go func() { errc <- g.reserve(ctx, 300) }()
+
+	// The smaller one fits right away, the other one waits
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+	waitForWaiters(t, g, 1)
+
+	if stats := g.Stats(); stats != (GovernorStats{Budget: 1000, Reserved: 900, Waiting: 1}) {
+		t.Errorf("stats %+v", stats)
+	}
+
+	cancel()
+	if err := <-errc; err != context.Canceled {
+		t.Errorf("cancelled: %v, want context.Canceled", err)
+	}
+
+	if stats := g.Stats(); stats != (GovernorStats{Budget: 1000, Reserved: 900}) {
+		t.Errorf("stats %+v after the cancellation", stats)
+	}
+
+	go func() { errc <- g.reserve(nil, 600) }()
+	waitForWaiters(t, g, 1)
+	g.release(600)
+
+	if err := <-errc; err != nil {
+		t.Errorf("after the release: %v", err)
+	}
+	if stats := g.Stats(); stats != (GovernorStats{Budget: 1000, Reserved: 900}) {
+		t.Errorf("stats %+v after the release", stats)
+	}
+}
+
+func TestSetMemoryGovernor(t *testing.T) {
+	params := testPolicy
+	params.Password = []byte("password")
+	params.Salt = []byte("somesalt")
+	params.KeyLength = 32
+
+	g := NewMemoryGovernor(uint64(params.Memory)*1024-1, 0)
+	SetMemoryGovernor(g)
+	defer SetMemoryGovernor(nil)
+
+	if _, err := Derive(params); !errors.Is(err, ErrMemoryBudgetExceeded) {
+		t.Errorf("%v, want ErrMemoryBudgetExceeded", err)
+	}
+
+	g = NewMemoryGovernor(uint64(params.Memory)*1024, 0)
+	SetMemoryGovernor(g)
+	if _, err := Derive(params); err != nil {
+		t.Error(err)
+	}
+	if g.Stats().Reserved != 0 {
+		t.Errorf("%d bytes still reserved", g.Stats().Reserved)
+	}
+}
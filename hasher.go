@@ -0,0 +1,71 @@
+package argon2
+
+import (
+	"context"
+	"sync"
+)
+
+// Hasher derives keys just like Derive, but instead of allocating a new
+// memory matrix for every call it reuses the (wiped) matrices of the finished
+// derivations with the same memory size. The zero value is ready to use and
+// a Hasher is safe for concurrent use. The idle matrices are kept in a
+// sync.Pool, so the garbage collector frees them once they are no longer
+// needed. A MemoryGovernor only accounts for the matrices of the running
+// derivations, the idle ones are outside of its budget.
+type Hasher struct {
+	pools sync.Map // *sync.Pool of idle matrices, by number of blocks
+}
+
+// Derive derives an Argon2 hash using the passed parameters and a pooled
+// memory matrix.
+func (h *Hasher) Derive(params Params) ([]byte, error) {
+	return h.DeriveContext(context.Background(), params)
+}
+
+// DeriveContext is Derive with the cancellation of DeriveContext.
+func (h *Hasher) DeriveContext(ctx context.Context, params Params) ([]byte, error) {
+	if err := checkSelfTest(); err != nil {
+		return nil, err
+	}
+
+	// Prepare an output slice
+	output := make([]byte, params.KeyLength)
+
+	actx := params.argon2Context(output)
+	actx.labels = ctx
+	actx.allocate = h.allocate
+	actx.free = h.release
+	if ctx.Done() != nil {
+		actx.cancel = ctx
+	}
+
+	if err := core(&actx, params.Variant, params.Version); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// allocate takes an idle matrix out of the pool or makes a new one.
+func (h *Hasher) allocate(blocks uint32) []block {
+	if memory, ok := h.pool(blocks).Get().(*[]block); ok {
+		return *memory
+	}
+
+	return make([]block, blocks)
+}
+
+// release puts a wiped matrix back into the pool.
+func (h *Hasher) release(memory []block) {
+	h.pool(uint32(len(memory))).Put(&memory)
+}
+
+// pool returns the pool of the matrices of the given size.
+func (h *Hasher) pool(blocks uint32) *sync.Pool {
+	if pool, ok := h.pools.Load(blocks); ok {
+		return pool.(*sync.Pool)
+	}
+
+	pool, _ := h.pools.LoadOrStore(blocks, new(sync.Pool))
+	return pool.(*sync.Pool)
+}
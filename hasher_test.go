@@ -0,0 +1,64 @@
+package argon2
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestHasher(t *testing.T) {
+	var h Hasher
+
+	params := testPolicy
+	params.Password = []byte("password")
+	params.Salt = []byte("somesalt")
+	params.KeyLength = 32
+
+	want, err := Derive(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The second derivation runs on the matrix of the first one
+	for i := 0; i < 2; i++ {
+		out, err := h.Derive(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out, want) {
+			t.Errorf("derivation %d: %x, want %x", i, out, want)
+		}
+	}
+}
+
+func TestHasherCancel(t *testing.T) {
+	var h Hasher
+
+	blocks, _ := alignMemory(cancelParams.Memory, cancelParams.Lanes)
+
+	// The pool may drop a matrix, so retry until one comes back
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		_, err := h.DeriveContext(ctx, cancelParams)
+		cancel()
+
+		if err != context.DeadlineExceeded {
+			t.Fatalf("%v, want context.DeadlineExceeded", err)
+		}
+
+		memory, ok := h.pool(blocks).Get().(*[]block)
+		if !ok {
+			continue
+		}
+
+		for j := range *memory {
+			if (*memory)[j] != (block{}) {
+				t.Fatalf("block %d of the pooled matrix is not wiped", j)
+			}
+		}
+		return
+	}
+
+	t.Fatal("the cancelled derivation did not return its matrix to the pool")
+}
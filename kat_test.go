@@ -0,0 +1,272 @@
+package argon2
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// The files in testdata/kats follow the output of the genkat tool of the
+// reference implementation. The version 19 ones are the test vectors of
+// RFC 9106, section 5, the _v16 ones their version 1.0 counterparts.
+var katFiles = []string{
+	"argon2d",
+	"argon2i",
+	"argon2id",
+	"argon2d_v16",
+	"argon2i_v16",
+	"argon2id_v16",
+}
+
+var katVariants = map[string]Variant{
+	"argon2d":  Argon2d,
+	"argon2i":  Argon2i,
+	"argon2id": Argon2id,
+}
+
+// katVector is a single genkat test vector, including the memory after
+// every pass.
+type katVector struct {
+	variant  Variant
+	version  Version
+	memory   uint32
+	passes   uint32
+	lanes    uint32
+	password []byte
+	salt     []byte
+	secret   []byte
+	ad       []byte
+	prehash  []byte
+	blocks   [][]block
+	tag      []byte
+}
+
+func parseKAT(name string) (*katVector, error) {
+	f, err := os.Open(filepath.Join("testdata", "kats", name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		kat     katVector
+		scanner = bufio.NewScanner(f)
+		line    int
+	)
+
+	for scanner.Scan() {
+		line++
+
+		// The headers are preceded by a separator without a line break
+		text := strings.TrimLeft(scanner.Text(), "=")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, ":")
+		if !ok {
+			var variant string
+			if _, err := fmt.Sscanf(text, "%s version number %d", &variant, &kat.version); err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", name, line, err)
+			}
+
+			if kat.variant, ok = katVariants[strings.ToLower(variant)]; !ok {
+				return nil, fmt.Errorf("%s:%d: unknown variant %q", name, line, variant)
+			}
+			continue
+		}
+
+		switch {
+		case key == "Memory":
+			_, err = fmt.Sscanf(text, "Memory: %d KiB, Iterations: %d, Parallelism: %d lanes",
+				&kat.memory, &kat.passes, &kat.lanes)
+		case strings.HasPrefix(key, "Password["):
+			kat.password, err = parseKATHex(value)
+		case strings.HasPrefix(key, "Salt["):
+			kat.salt, err = parseKATHex(value)
+		case strings.HasPrefix(key, "Secret["):
+			kat.secret, err = parseKATHex(value)
+		case strings.HasPrefix(key, "Associated data["):
+			kat.ad, err = parseKATHex(value)
+		case key == "Pre-hashing digest":
+			kat.prehash, err = parseKATHex(value)
+		case key == "Tag":
+			kat.tag, err = parseKATHex(value)
+		case strings.HasPrefix(key, " After pass "):
+			kat.blocks = append(kat.blocks, make([]block, kat.memory))
+		case strings.HasPrefix(key, "Block "):
+			var (
+				index, word int
+				memory      = kat.blocks[len(kat.blocks)-1]
+			)
+			if _, err = fmt.Sscanf(key, "Block %d [%d]", &index, &word); err == nil {
+				memory[index][word], err = strconv.ParseUint(strings.TrimSpace(value), 16, 64)
+			}
+		default:
+			err = fmt.Errorf("unknown line")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", name, line, err)
+		}
+	}
+
+	return &kat, scanner.Err()
+}
+
+func parseKATHex(value string) ([]byte, error) {
+	return hex.DecodeString(strings.ReplaceAll(value, " ", ""))
+}
+
+// TestKATCore checks the pre-hashing digest, the memory after every pass
+// and the tag produced by core, with every possible number of threads.
+func TestKATCore(t *testing.T) {
+	for _, name := range katFiles {
+		kat, err := parseKAT(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for threads := uint32(1); threads <= kat.lanes; threads++ {
+			var (
+				memory []block
+				dumps  [][]block
+				out    = make([]byte, len(kat.tag))
+			)
+
+			ctx := argon2Context{
+				out:        out,
+				pwd:        kat.password,
+				salt:       kat.salt,
+				secret:     kat.secret,
+				ad:         kat.ad,
+				timeCost:   kat.passes,
+				memoryCost: kat.memory,
+				lanes:      kat.lanes,
+				threads:    threads,
+				allocate: func(blocks uint32) []block {
+					memory = make([]block, blocks)
+					return memory
+				},
+				progress: func(pass, slice, passes uint32) {
+					if slice == syncPoints-1 {
+						dumps = append(dumps, append([]block(nil), memory...))
+					}
+				},
+			}
+
+			var blockhash [prehashSeedLength]byte
+			initialHash(&blockhash, &ctx, kat.variant, kat.version)
+			if !bytes.Equal(blockhash[:prehashDigestLength], kat.prehash) {
+				t.Errorf("%s: pre-hashing digest %x, want %x", name, blockhash[:prehashDigestLength], kat.prehash)
+			}
+
+			if err := core(&ctx, kat.variant, kat.version); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			if len(dumps) != len(kat.blocks) {
+				t.Fatalf("%s: %d passes, want %d", name, len(dumps), len(kat.blocks))
+			}
+			for pass := range dumps {
+				for i := range dumps[pass] {
+					if dumps[pass][i] != kat.blocks[pass][i] {
+						t.Fatalf("%s, %d threads: block %d differs after pass %d", name, threads, i, pass)
+					}
+				}
+			}
+
+			if !bytes.Equal(out, kat.tag) {
+				t.Errorf("%s, %d threads: tag %x, want %x", name, threads, out, kat.tag)
+			}
+		}
+	}
+}
+
+// TestKATDerive checks the tags through the public API.
+func TestKATDerive(t *testing.T) {
+	for _, name := range katFiles {
+		kat, err := parseKAT(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		out, err := Derive(Params{
+			Password:       kat.password,
+			Salt:           kat.salt,
+			Secret:         kat.secret,
+			AssociatedData: kat.ad,
+			Time:           kat.passes,
+			Memory:         kat.memory,
+			Lanes:          kat.lanes,
+			KeyLength:      uint32(len(kat.tag)),
+			Variant:        kat.variant,
+			Version:        kat.version,
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		if !bytes.Equal(out, kat.tag) {
+			t.Errorf("%s: tag %x, want %x", name, out, kat.tag)
+		}
+	}
+}
+
+// TestKATKey checks Key against the vectors of the reference test suite.
+// The ones using 256 MiB are skipped in short mode.
+func TestKATKey(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "key_vectors"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) != 8 {
+			t.Fatalf("key_vectors:%d: %d fields, want 8", i+1, len(fields))
+		}
+
+		var numbers [4]uint32
+		for j := range numbers {
+			n, err := strconv.ParseUint(fields[j+1], 10, 32)
+			if err != nil {
+				t.Fatalf("key_vectors:%d: %v", i+1, err)
+			}
+			numbers[j] = uint32(n)
+		}
+
+		variant, ok := katVariants[fields[0]]
+		if !ok {
+			t.Fatalf("key_vectors:%d: unknown variant %q", i+1, fields[0])
+		}
+
+		want, err := hex.DecodeString(fields[7])
+		if err != nil {
+			t.Fatalf("key_vectors:%d: %v", i+1, err)
+		}
+
+		version, time, memory, parallelism := Version(numbers[0]), numbers[1], numbers[2], numbers[3]
+		if testing.Short() && memory > 65536 {
+			continue
+		}
+
+		out, err := Key([]byte(fields[5]), []byte(fields[6]), time, parallelism, memory, len(want), variant, version)
+		if err != nil {
+			t.Fatalf("key_vectors:%d: %v", i+1, err)
+		}
+
+		if !bytes.Equal(out, want) {
+			t.Errorf("key_vectors:%d: %x, want %x", i+1, out, want)
+		}
+	}
+}
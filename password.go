@@ -0,0 +1,123 @@
+package argon2
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+)
+
+// Defaults used by GenerateFromPassword
+const (
+	DefaultSaltLength = 16
+	DefaultKeyLength  = 32
+)
+
+// GenerateFromPassword hashes the password with a fresh random salt and
+// returns the result in the PHC string format. Password and Salt of the
+// params are ignored, a zero KeyLength selects DefaultKeyLength. The secret
+// can not be stored in the encoded hash, so it has to be left empty.
+func GenerateFromPassword(password []byte, params Params) (string, error) {
+	if len(params.Secret) != 0 {
+		return "", ErrSecretNotEncodable
+	}
+
+	if params.KeyLength == 0 {
+		params.KeyLength = DefaultKeyLength
+	}
+	if params.Version == 0 {
+		params.Version = DefaultVersion
+	}
+
+	params.Password = password
+	params.Salt = make([]byte, DefaultSaltLength)
+	if _, err := rand.Read(params.Salt); err != nil {
+		return "", err
+	}
+
+	key, err := Derive(params)
+	if err != nil {
+		return "", err
+	}
+
+	h := &Hash{
+		Variant: params.Variant,
+		Version: params.Version,
+		Memory:  params.Memory,
+		Time:    params.Time,
+		Lanes:   params.Lanes,
+		Data:    params.AssociatedData,
+		Salt:    params.Salt,
+		Key:     key,
+	}
+
+	return h.String(), nil
+}
+
+// CompareHashAndPassword checks whether the password matches the encoded
+// hash. It returns nil on success, ErrMismatchedHashAndPassword if the
+// password is wrong or a *ParseError if the hash is malformed. Hashes with a
+// keyid were derived with a secret that is not part of the encoded hash, they
+// return ErrKeyIDNotSupported.
+func CompareHashAndPassword(encoded string, password []byte) error {
+	h, err := ParseHash(encoded)
+	if err != nil {
+		return err
+	}
+
+	if h.KeyID != nil {
+		return ErrKeyIDNotSupported
+	}
+
+	params := h.Params()
+	params.Password = password
+
+	key, err := Derive(params)
+	if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare(key, h.Key) != 1 {
+		return ErrMismatchedHashAndPassword
+	}
+
+	return nil
+}
+
+// Params returns the parameters that were used to derive the hash. The
+// password is left empty.
+func (h *Hash) Params() Params {
+	return Params{
+		Salt:           h.Salt,
+		AssociatedData: h.Data,
+		Time:           h.Time,
+		Memory:         h.Memory,
+		Lanes:          h.Lanes,
+		KeyLength:      uint32(len(h.Key)),
+		Variant:        h.Variant,
+		Version:        h.Version,
+	}
+}
+
+// NeedsRehash reports whether the encoded hash was created with parameters
+// different from the policy, so that it should be replaced after the next
+// successful CompareHashAndPassword. Zero Version and KeyLength of the policy
+// select the same defaults as GenerateFromPassword.
+func NeedsRehash(encoded string, policy Params) (bool, error) {
+	h, err := ParseHash(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	if policy.KeyLength == 0 {
+		policy.KeyLength = DefaultKeyLength
+	}
+	if policy.Version == 0 {
+		policy.Version = DefaultVersion
+	}
+
+	return h.Variant != policy.Variant ||
+		h.Version != policy.Version ||
+		h.Memory != policy.Memory ||
+		h.Time != policy.Time ||
+		h.Lanes != policy.Lanes ||
+		uint32(len(h.Key)) != policy.KeyLength, nil
+}
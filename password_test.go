@@ -0,0 +1,169 @@
+package argon2
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Small costs, the tests are about the encoding and not the derivation
+var testPolicy = Params{
+	Time:    1,
+	Memory:  64,
+	Lanes:   2,
+	Variant: Argon2id,
+}
+
+func TestGenerateAndCompare(t *testing.T) {
+	for _, variant := range []Variant{Argon2d, Argon2i, Argon2id} {
+		for _, data := range [][]byte{nil, []byte("user@example.com")} {
+			params := testPolicy
+			params.Variant = variant
+			params.AssociatedData = data
+
+			encoded, err := GenerateFromPassword([]byte("password"), params)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.HasPrefix(encoded, "$"+variant.String()+"$v=19$m=64,t=1,p=2") {
+				t.Errorf("unexpected encoding %s", encoded)
+			}
+
+			if err := CompareHashAndPassword(encoded, []byte("password")); err != nil {
+				t.Errorf("%s: %v", encoded, err)
+			}
+
+			for _, wrong := range []string{"", "Password", "password\n"} {
+				if err := CompareHashAndPassword(encoded, []byte(wrong)); err != ErrMismatchedHashAndPassword {
+					t.Errorf("%s, %q: %v, want ErrMismatchedHashAndPassword", encoded, wrong, err)
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateFromPasswordSalt(t *testing.T) {
+	a, err := GenerateFromPassword([]byte("password"), testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := GenerateFromPassword([]byte("password"), testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ha, _ := ParseHash(a)
+	hb, _ := ParseHash(b)
+	if len(ha.Salt) != DefaultSaltLength || len(ha.Key) != DefaultKeyLength {
+		t.Errorf("salt of %d bytes and key of %d bytes", len(ha.Salt), len(ha.Key))
+	}
+	if string(ha.Salt) == string(hb.Salt) {
+		t.Error("two hashes share the same salt")
+	}
+}
+
+func TestGenerateFromPasswordSecret(t *testing.T) {
+	params := testPolicy
+	params.Secret = []byte("pepper")
+
+	if _, err := GenerateFromPassword([]byte("password"), params); err != ErrSecretNotEncodable {
+		t.Errorf("%v, want ErrSecretNotEncodable", err)
+	}
+}
+
+func TestCompareHashAndPasswordKeyID(t *testing.T) {
+	encoded, err := GenerateFromPassword([]byte("password"), testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h, _ := ParseHash(encoded)
+	h.KeyID = []byte("pepper-1")
+
+	if err := CompareHashAndPassword(h.String(), []byte("password")); err != ErrKeyIDNotSupported {
+		t.Errorf("%v, want ErrKeyIDNotSupported", err)
+	}
+}
+
+func TestCompareHashAndPasswordMalformed(t *testing.T) {
+	err := CompareHashAndPassword("$argon2id$v=19$m=64,t=1,p=2$c29tZXNhbHQ", []byte("password"))
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("%v, want a *ParseError", err)
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	current := (&Hash{
+		Variant: Argon2id,
+		Version: V13,
+		Memory:  64,
+		Time:    1,
+		Lanes:   2,
+		Salt:    []byte("somesalt"),
+		Key:     make([]byte, DefaultKeyLength),
+	}).String()
+
+	change := func(f func(p *Params)) Params {
+		policy := testPolicy
+		f(&policy)
+		return policy
+	}
+
+	tests := []struct {
+		name   string
+		policy Params
+		want   bool
+	}{
+		{"same", testPolicy, false},
+		{"explicit defaults", change(func(p *Params) { p.Version, p.KeyLength = V13, DefaultKeyLength }), false},
+		{"variant", change(func(p *Params) { p.Variant = Argon2i }), true},
+		{"version", change(func(p *Params) { p.Version = V10 }), true},
+		{"memory", change(func(p *Params) { p.Memory = 128 }), true},
+		{"time", change(func(p *Params) { p.Time = 2 }), true},
+		{"lanes", change(func(p *Params) { p.Lanes = 1 }), true},
+		{"key length", change(func(p *Params) { p.KeyLength = 64 }), true},
+	}
+
+	for _, tt := range tests {
+		got, err := NeedsRehash(current, tt.policy)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// The zero Version and KeyLength of the policy are not wildcards
+	old := (&Hash{
+		Variant: Argon2id,
+		Version: V10,
+		Memory:  64,
+		Time:    1,
+		Lanes:   2,
+		Salt:    []byte("somesalt"),
+		Key:     make([]byte, 16),
+	}).String()
+
+	for _, policy := range []Params{
+		testPolicy,
+		change(func(p *Params) { p.Version = V10 }),
+		change(func(p *Params) { p.KeyLength = 16 }),
+	} {
+		if got, err := NeedsRehash(old, policy); err != nil || !got {
+			t.Errorf("version %d, key length %d: %v, %v, want true", policy.Version, policy.KeyLength, got, err)
+		}
+	}
+
+	if got, err := NeedsRehash(old, change(func(p *Params) { p.Version, p.KeyLength = V10, 16 })); err != nil || got {
+		t.Errorf("matching policy: %v, %v, want false", got, err)
+	}
+
+	var parseErr *ParseError
+	if _, err := NeedsRehash("$argon2id$v=19$m=64", testPolicy); !errors.As(err, &parseErr) {
+		t.Errorf("%v, want a *ParseError", err)
+	}
+}
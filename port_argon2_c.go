@@ -1,24 +1,21 @@
 package argon2
 
-func core(ctx *context, variant Variant) error {
-	/* 1. Validate all inputs */
+func core(ctx *argon2Context, variant Variant, version Version) error {
+	/* 1. Validate all inputs, in the order of ValidateParams */
+	if errs := typeErrors(variant, version); len(errs) != 0 {
+		return errs[0]
+	}
+
 	if err := validateInputs(ctx); err != nil {
 		return err
 	}
 
-	if variant != Argon2d && variant != Argon2i {
-		return ErrIncorrectType
+	if version == 0 {
+		version = DefaultVersion
 	}
 
 	/* 2. Align memory size */
-	memoryBlocks := ctx.memoryCost
-	if memoryBlocks < 2*syncPoints*ctx.lanes {
-		memoryBlocks = 2 * syncPoints * ctx.lanes
-	}
-
-	segmentLength := memoryBlocks / (ctx.lanes * syncPoints)
-	// Ensure that all segments have equal length
-	memoryBlocks = segmentLength * (ctx.lanes * syncPoints)
+	memoryBlocks, segmentLength := alignMemory(ctx.memoryCost, ctx.lanes)
 
 	ins := instance{
 		memory:        nil,
@@ -29,20 +26,36 @@ func core(ctx *context, variant Variant) error {
 		lanes:         ctx.lanes,
 		threads:       ctx.threads,
 		variant:       variant,
+		version:       version,
+		cancel:        ctx.cancel,
+		progress:      ctx.progress,
+		labels:        ctx.labels,
+	}
+
+	/* 3. Reserve the memory with the process-wide governor, it is
+	   returned after the memory is wiped */
+	if g := governor.Load(); g != nil && !ctx.ungoverned {
+		bytes := uint64(memoryBlocks) * blockSize
+		if err := g.reserve(ctx.cancel, bytes); err != nil {
+			return err
+		}
+		defer g.release(bytes)
 	}
 
-	/* 3. Initialization: Hashing inputs, allocating memory, filling
-	   first blocks. */
+	/* 4. Initialization: Hashing inputs, allocating memory, filling
+	   first blocks. The memory is wiped on every return path. */
+	defer freeMemory(ctx, &ins)
+
 	if err := initialize(&ins, ctx); err != nil {
 		return err
 	}
 
-	/* 4. Filling memory */
+	/* 5. Filling memory */
 	if err := fillMemoryBlocks(&ins); err != nil {
 		return err
 	}
 
-	/* 5. Perform the final hash */
+	/* 6. Perform the final hash */
 	if err := finalize(ctx, &ins); err != nil {
 		return err
 	}
@@ -50,12 +63,28 @@ func core(ctx *context, variant Variant) error {
 	return nil
 }
 
-func finalize(ctx *context, ins *instance) error {
+// alignMemory returns the number of blocks actually allocated for the memory
+// cost and the length of a segment.
+func alignMemory(memoryCost, lanes uint32) (memoryBlocks, segmentLength uint32) {
+	memoryBlocks = memoryCost
+	if memoryBlocks < 2*syncPoints*lanes {
+		memoryBlocks = 2 * syncPoints * lanes
+	}
+
+	segmentLength = memoryBlocks / (lanes * syncPoints)
+	// Ensure that all segments have equal length
+	memoryBlocks = segmentLength * (lanes * syncPoints)
+
+	return memoryBlocks, segmentLength
+}
+
+func finalize(ctx *argon2Context, ins *instance) error {
 	if ctx == nil || ins == nil {
 		return ErrIncorrectParameter
 	}
 
 	var blockhash block
+	defer clearBlock(&blockhash)
 
 	copy(blockhash[:], ins.memory[ins.laneLength-1][:])
 
@@ -68,6 +97,8 @@ func finalize(ctx *context, ins *instance) error {
 	/* Hash the result */
 	{
 		var blockhashBytes [blockSize]byte
+		defer clearInternalMemory(blockhashBytes[:])
+
 		storeBlock(blockhashBytes[:], &blockhash)
 		if err := blakeLong(ctx.out, blockhashBytes[:]); err != nil {
 			return err
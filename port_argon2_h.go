@@ -1,5 +1,9 @@
 package argon2
 
+import (
+	"context"
+)
+
 const (
 	minLanes          = 1
 	maxLanes          = 0xFFFFFF
@@ -22,7 +26,7 @@ const (
 	maxSecretLength   = 0xFFFFFFFF
 )
 
-type context struct {
+type argon2Context struct {
 	out        []byte
 	pwd        []byte
 	salt       []byte
@@ -32,13 +36,58 @@ type context struct {
 	memoryCost uint32
 	lanes      uint32
 	threads    uint32
+	flags      Flags
+
+	// Optional memory allocation callbacks, the memory passed to free is
+	// already wiped
+	allocate func(blocks uint32) []block
+	free     func(memory []block)
+
+	// Optional cancellation, checked at every synchronization point
+	cancel context.Context
+
+	// Optional progress reporting, called at every synchronization point
+	progress ProgressFunc
+
+	// Optional context of the caller, the lanes are labeled on top of its pprof labels
+	labels context.Context
+
+	// Bypasses the memory governor, only for the self-test
+	ungoverned bool
 }
 
 // Variant is the type of algorithm to use
 type Variant uint8
 
 // Argon2i uses data-derived pseudorandom numbers, protecting from side-channel attacks.
+// Argon2id uses them only for the first half of the first pass and is the
+// recommended variant for password hashing.
 const (
 	Argon2d Variant = iota
 	Argon2i
+	Argon2id
+)
+
+// Flags control the optional wiping of the inputs
+type Flags uint32
+
+// FlagClearPassword zeroes the password slice and FlagClearSecret zeroes the
+// secret slice as soon as they are hashed. The internal memory is always
+// wiped.
+const (
+	FlagClearPassword Flags = 1 << iota
+	FlagClearSecret
+)
+
+// Version is the version number of the algorithm
+type Version uint32
+
+// V10 is the original algorithm, vulnerable to some tradeoff attacks. V13
+// XORs the new blocks into the memory on every pass after the first one.
+const (
+	V10 Version = 0x10
+	V13 Version = 0x13
+
+	// DefaultVersion is used when the version is left unset
+	DefaultVersion = V13
 )
@@ -2,37 +2,165 @@ package argon2
 
 import (
 	"encoding/binary"
-
-	"github.com/dchest/blake2b"
+	"math/bits"
 )
 
 const (
-	blakeOutBytes = 64
+	blakeBlockBytes = 128
+	blakeOutBytes   = 64
 )
 
+var blakeIV = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b,
+	0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f,
+	0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+var blakeSigma = [12][16]uint8{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+}
+
+// BLAKE2b state without a key, salt or personalization. It is a plain value
+// living on the stack of its user, so hashing never allocates.
+type blakeState struct {
+	h      [8]uint64
+	t      [2]uint64
+	buf    [blakeBlockBytes]byte
+	buflen int
+	outlen int
+}
+
+func (s *blakeState) init(outlen int) {
+	*s = blakeState{
+		h:      blakeIV,
+		outlen: outlen,
+	}
+
+	// Parameter block: digest length, no key, fanout and depth of 1
+	s.h[0] ^= 0x01010000 ^ uint64(outlen)
+}
+
+func (s *blakeState) update(in []byte) {
+	for len(in) > 0 {
+		// The last block is only compressed by final
+		if s.buflen == blakeBlockBytes {
+			s.increment(blakeBlockBytes)
+			s.compress(false)
+			s.buflen = 0
+		}
+
+		n := copy(s.buf[s.buflen:], in)
+		s.buflen += n
+		in = in[n:]
+	}
+}
+
+func (s *blakeState) updateUint32(value uint32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], value)
+	s.update(buf[:])
+}
+
+// final writes the outlen bytes of the digest to out and wipes the state.
+func (s *blakeState) final(out []byte) {
+	s.increment(uint64(s.buflen))
+	for i := s.buflen; i < blakeBlockBytes; i++ {
+		s.buf[i] = 0
+	}
+	s.compress(true)
+
+	var buffer [blakeOutBytes]byte
+	for i, v := range s.h {
+		binary.LittleEndian.PutUint64(buffer[i*8:], v)
+	}
+	copy(out[:s.outlen], buffer[:])
+
+	clearInternalMemory(buffer[:])
+	s.clear()
+}
+
+func (s *blakeState) clear() {
+	*s = blakeState{}
+	clearInternalMemory(s.buf[:])
+}
+
+func (s *blakeState) increment(n uint64) {
+	s.t[0] += n
+	if s.t[0] < n {
+		s.t[1]++
+	}
+}
+
+func (s *blakeState) compress(last bool) {
+	var m, v [16]uint64
+
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(s.buf[i*8:])
+	}
+
+	copy(v[:8], s.h[:])
+	copy(v[8:], blakeIV[:])
+	v[12] ^= s.t[0]
+	v[13] ^= s.t[1]
+	if last {
+		v[14] = ^v[14]
+	}
+
+	g := func(a, b, c, d int, x, y uint64) {
+		v[a] += v[b] + x
+		v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -24)
+		v[a] += v[b] + y
+		v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -63)
+	}
+
+	for _, sigma := range &blakeSigma {
+		g(0, 4, 8, 12, m[sigma[0]], m[sigma[1]])
+		g(1, 5, 9, 13, m[sigma[2]], m[sigma[3]])
+		g(2, 6, 10, 14, m[sigma[4]], m[sigma[5]])
+		g(3, 7, 11, 15, m[sigma[6]], m[sigma[7]])
+		g(0, 5, 10, 15, m[sigma[8]], m[sigma[9]])
+		g(1, 6, 11, 12, m[sigma[10]], m[sigma[11]])
+		g(2, 7, 8, 13, m[sigma[12]], m[sigma[13]])
+		g(3, 4, 9, 14, m[sigma[14]], m[sigma[15]])
+	}
+
+	for i := range s.h {
+		s.h[i] ^= v[i] ^ v[i+8]
+	}
+}
+
 func blakeLong(out []byte, in []byte) error {
 	var (
-		outlen      = len(out)
-		outlenBytes = make([]byte, 4)
+		outlen = len(out)
+		state  blakeState
 	)
-	binary.LittleEndian.PutUint32(outlenBytes, uint32(outlen))
-
-	if len(out) <= blakeOutBytes {
-		hash, err := blake2b.New(&blake2b.Config{
-			Size: uint8(outlen),
-		})
-		if err != nil {
-			return err
-		}
+	defer state.clear()
 
-		if _, err = hash.Write(outlenBytes); err != nil {
-			return err
-		}
-		if _, err = hash.Write(in); err != nil {
-			return err
-		}
-		sum := hash.Sum(nil)
-		copy(out, sum)
+	if outlen == 0 {
+		return ErrOutputPtrNull
+	}
+
+	if outlen <= blakeOutBytes {
+		state.init(outlen)
+		state.updateUint32(uint32(outlen))
+		state.update(in)
+		state.final(out)
 		return nil
 	}
 
@@ -40,49 +168,28 @@ func blakeLong(out []byte, in []byte) error {
 		toProduce uint32
 		buffer    [blakeOutBytes]byte
 	)
+	defer clearInternalMemory(buffer[:])
 
-	hash, err := blake2b.New(&blake2b.Config{
-		Size: blakeOutBytes,
-	})
-	if err != nil {
-		return err
-	}
-
-	if _, err = hash.Write(outlenBytes); err != nil {
-		return err
-	}
-	if _, err = hash.Write(in); err != nil {
-		return err
-	}
-	sum := hash.Sum(nil)
-	copy(out, sum[:blakeOutBytes/2])
+	state.init(blakeOutBytes)
+	state.updateUint32(uint32(outlen))
+	state.update(in)
+	state.final(buffer[:])
+	copy(out, buffer[:blakeOutBytes/2])
 	out = out[blakeOutBytes/2:]
 	toProduce = uint32(outlen) - blakeOutBytes/2
 
 	for toProduce > blakeOutBytes {
-		copy(buffer[:], sum)
-		hash.Reset()
-		if _, err = hash.Write(buffer[:]); err != nil {
-			return err
-		}
-		sum = hash.Sum(nil)
-		copy(out, sum[:blakeOutBytes/2])
+		state.init(blakeOutBytes)
+		state.update(buffer[:])
+		state.final(buffer[:])
+		copy(out, buffer[:blakeOutBytes/2])
 		out = out[blakeOutBytes/2:]
 		toProduce -= blakeOutBytes / 2
 	}
 
-	copy(buffer[:], sum[:blakeOutBytes])
-	hash, err = blake2b.New(&blake2b.Config{
-		Size: uint8(toProduce),
-	})
-	if err != nil {
-		return err
-	}
-	if _, err = hash.Write(buffer[:]); err != nil {
-		return err
-	}
-	sum = hash.Sum(nil)
-	copy(out, sum)
+	state.init(int(toProduce))
+	state.update(buffer[:])
+	state.final(out)
 
 	return nil
 }
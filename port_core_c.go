@@ -2,11 +2,10 @@ package argon2
 
 import (
 	"encoding/binary"
-
-	"github.com/dchest/blake2b"
+	"runtime"
 )
 
-func validateInputs(ctx *context) error {
+func validateInputs(ctx *argon2Context) error {
 	if ctx == nil {
 		return ErrIncorrectParameter
 	}
@@ -15,108 +14,110 @@ func validateInputs(ctx *context) error {
 		return ErrOutputPtrNull
 	}
 
-	if len(ctx.out) < minOutlen {
-		return ErrOutputTooShort
+	if errs := inputErrors(ctx, uint64(len(ctx.out))); len(errs) != 0 {
+		return errs[0]
 	}
 
-	if len(ctx.out) > maxOutlen {
-		return ErrOutputTooLong
-	}
+	return nil
+}
 
-	if ctx.pwd != nil {
-		if len(ctx.pwd) < minPasswordLength {
-			return ErrPwdTooShort
+// inputErrors returns all the inputs of the context outside of their bounds.
+// The output length is passed separately, so that the parameters can be
+// checked before the output is allocated.
+func inputErrors(ctx *argon2Context, outlen uint64) []*ParamError {
+	var errs []*ParamError
+
+	check := func(field string, value, min, max uint64, tooSmall, tooLarge error) {
+		if value < min {
+			errs = append(errs, &ParamError{Field: field, Value: value, Min: min, Max: max, Err: tooSmall})
+		} else if value > max {
+			errs = append(errs, &ParamError{Field: field, Value: value, Min: min, Max: max, Err: tooLarge})
 		}
+	}
 
-		if len(ctx.pwd) > maxPasswordLength {
-			return ErrPwdTooLong
-		}
+	check("KeyLength", outlen, minOutlen, maxOutlen, ErrOutputTooShort, ErrOutputTooLong)
+
+	if ctx.pwd != nil {
+		check("Password", uint64(len(ctx.pwd)), minPasswordLength, maxPasswordLength, ErrPwdTooShort, ErrPwdTooLong)
 	}
 
 	if ctx.salt != nil {
-		if len(ctx.salt) < minSaltLength {
-			return ErrSaltTooShort
-		}
-
-		if len(ctx.salt) > maxSaltLength {
-			return ErrSaltTooLong
-		}
+		check("Salt", uint64(len(ctx.salt)), minSaltLength, maxSaltLength, ErrSaltTooShort, ErrSaltTooLong)
 	}
 
 	if ctx.secret != nil {
-		if len(ctx.secret) < minSecretLength {
-			return ErrSecretTooShort
-		}
-
-		if len(ctx.secret) > maxSecretLength {
-			return ErrSecretTooLong
-		}
+		check("Secret", uint64(len(ctx.secret)), minSecretLength, maxSecretLength, ErrSecretTooShort, ErrSecretTooLong)
 	}
 
 	if ctx.ad != nil {
-		if len(ctx.ad) < minADLength {
-			return ErrADTooShort
-		}
-
-		if len(ctx.ad) > maxADLength {
-			return ErrADTooLong
-		}
+		check("AssociatedData", uint64(len(ctx.ad)), minADLength, maxADLength, ErrADTooShort, ErrADTooLong)
 	}
 
-	// Validate memory cost
-	if ctx.memoryCost < minMemory {
-		return ErrMemoryTooLittle
-	}
-	if ctx.memoryCost > maxMemory {
-		return ErrMemoryTooMuch
-	}
-	if ctx.memoryCost < 8*ctx.lanes {
-		return ErrMemoryTooLittle
+	// Validate memory cost, at least 8 blocks per lane
+	minMemoryCost := uint64(minMemory)
+	if 8*uint64(ctx.lanes) > minMemoryCost {
+		minMemoryCost = 8 * uint64(ctx.lanes)
 	}
+	check("Memory", uint64(ctx.memoryCost), minMemoryCost, maxMemory, ErrMemoryTooLittle, ErrMemoryTooMuch)
 
 	// Validate time cost
-	if ctx.timeCost < minTime {
-		return ErrTimeTooSmall
-	}
-	if ctx.timeCost > maxTime {
-		return ErrTimeTooLarge
-	}
+	check("Time", uint64(ctx.timeCost), minTime, maxTime, ErrTimeTooSmall, ErrTimeTooLarge)
 
 	// Validate lanes
-	if ctx.lanes < minLanes {
-		return ErrLanesTooFew
-	}
-	if ctx.lanes > maxLanes {
-		return ErrLanesTooMany
-	}
+	check("Lanes", uint64(ctx.lanes), minLanes, maxLanes, ErrLanesTooFew, ErrLanesTooMany)
 
 	// Validate threads
-	if ctx.threads < minThreads {
-		return ErrThreadsTooFew
+	check("Threads", uint64(ctx.threads), minThreads, maxThreads, ErrThreadsTooFew, ErrThreadsTooMany)
+
+	return errs
+}
+
+// typeErrors checks the variant and the version, zero meaning DefaultVersion.
+func typeErrors(variant Variant, version Version) []*ParamError {
+	var errs []*ParamError
+
+	if variant != Argon2d && variant != Argon2i && variant != Argon2id {
+		errs = append(errs, &ParamError{
+			Field: "Variant",
+			Value: uint64(variant),
+			Min:   uint64(Argon2d),
+			Max:   uint64(Argon2id),
+			Err:   ErrIncorrectType,
+		})
 	}
-	if ctx.threads > maxThreads {
-		return ErrThreadsTooMany
+
+	if version != 0 && version != V10 && version != V13 {
+		errs = append(errs, &ParamError{
+			Field: "Version",
+			Value: uint64(version),
+			Min:   uint64(V10),
+			Max:   uint64(V13),
+			Err:   ErrIncorrectVersion,
+		})
 	}
 
-	return nil
+	return errs
 }
 
-func initialize(ins *instance, ctx *context) error {
+func initialize(ins *instance, ctx *argon2Context) error {
 	if ins == nil || ctx == nil {
 		return ErrIncorrectParameter
 	}
 
 	/* 1. Memory allocation */
-	ins.memory = make([]block, ins.memoryBlocks)
+	if ctx.allocate != nil {
+		ins.memory = ctx.allocate(ins.memoryBlocks)
+	} else {
+		ins.memory = make([]block, ins.memoryBlocks)
+	}
 
 	/* 2. Initial hashing */
 	// H_0 + 8 extra bytes to produce the first blocks
 	blockhash := [prehashSeedLength]byte{}
+	defer clearInternalMemory(blockhash[:])
 
 	// Hash all inputs
-	if err := initialHash(&blockhash, ctx, ins.variant); err != nil {
-		return err
-	}
+	initialHash(&blockhash, ctx, ins.variant, ins.version)
 
 	/* 3. Creating first blocks, we always have at least two blocks in a slice */
 	if err := fillFirstBlocks(&blockhash, ins); err != nil {
@@ -126,113 +127,98 @@ func initialize(ins *instance, ctx *context) error {
 	return nil
 }
 
-func initialHash(blockhash *[prehashSeedLength]byte, ctx *context, variant Variant) error {
-	state, err := blake2b.New(&blake2b.Config{
-		Size: prehashDigestLength,
-	})
-	if err != nil {
-		return err
+// freeMemory wipes and releases the memory matrix.
+func freeMemory(ctx *argon2Context, ins *instance) {
+	if ins.memory == nil {
+		return
 	}
 
-	value := make([]byte, 4) // 32-bit expressed in 4xuint8
-
-	binary.LittleEndian.PutUint32(value, ctx.lanes)
-	if _, err = state.Write(value); err != nil {
-		return err
+	for i := range ins.memory {
+		ins.memory[i] = block{}
 	}
+	runtime.KeepAlive(ins.memory)
 
-	binary.LittleEndian.PutUint32(value, uint32(len(ctx.out)))
-	if _, err = state.Write(value); err != nil {
-		return err
+	if ctx.free != nil {
+		ctx.free(ins.memory)
 	}
+	ins.memory = nil
+}
 
-	binary.LittleEndian.PutUint32(value, ctx.memoryCost)
-	if _, err = state.Write(value); err != nil {
-		return err
-	}
+// clearBlock zeroes a single block.
+func clearBlock(b *block) {
+	*b = block{}
+	runtime.KeepAlive(b)
+}
 
-	binary.LittleEndian.PutUint32(value, ctx.timeCost)
-	if _, err = state.Write(value); err != nil {
-		return err
+// clearInternalMemory zeroes a buffer holding data derived from the inputs.
+// KeepAlive keeps the compiler from dropping the stores as dead.
+func clearInternalMemory(buf []byte) {
+	for i := range buf {
+		buf[i] = 0
 	}
+	runtime.KeepAlive(buf)
+}
 
-	binary.LittleEndian.PutUint32(value, versionNumber)
-	if _, err = state.Write(value); err != nil {
-		return err
-	}
+func initialHash(blockhash *[prehashSeedLength]byte, ctx *argon2Context, variant Variant, version Version) {
+	var state blakeState
 
-	binary.LittleEndian.PutUint32(value, uint32(variant))
-	if _, err = state.Write(value); err != nil {
-		return err
-	}
+	state.init(prehashDigestLength)
 
-	binary.LittleEndian.PutUint32(value, uint32(len(ctx.pwd)))
-	if _, err = state.Write(value); err != nil {
-		return err
-	}
+	state.updateUint32(ctx.lanes)
+	state.updateUint32(uint32(len(ctx.out)))
+	state.updateUint32(ctx.memoryCost)
+	state.updateUint32(ctx.timeCost)
+	state.updateUint32(uint32(version))
+	state.updateUint32(uint32(variant))
 
+	state.updateUint32(uint32(len(ctx.pwd)))
 	if ctx.pwd != nil {
-		if _, err = state.Write(ctx.pwd); err != nil {
-			return err
-		}
-	}
+		state.update(ctx.pwd)
 
-	binary.LittleEndian.PutUint32(value, uint32(len(ctx.salt)))
-	if _, err = state.Write(value); err != nil {
-		return err
-	}
-
-	if ctx.salt != nil {
-		if _, err = state.Write(ctx.salt); err != nil {
-			return err
+		if ctx.flags&FlagClearPassword != 0 {
+			clearInternalMemory(ctx.pwd)
 		}
 	}
 
-	binary.LittleEndian.PutUint32(value, uint32(len(ctx.secret)))
-	if _, err = state.Write(value); err != nil {
-		return err
+	state.updateUint32(uint32(len(ctx.salt)))
+	if ctx.salt != nil {
+		state.update(ctx.salt)
 	}
 
+	state.updateUint32(uint32(len(ctx.secret)))
 	if ctx.secret != nil {
-		if _, err = state.Write(ctx.secret); err != nil {
-			return err
-		}
-	}
+		state.update(ctx.secret)
 
-	binary.LittleEndian.PutUint32(value, uint32(len(ctx.ad)))
-	if _, err = state.Write(value); err != nil {
-		return err
-	}
-
-	if ctx.ad != nil {
-		if _, err = state.Write(ctx.ad); err != nil {
-			return err
+		if ctx.flags&FlagClearSecret != 0 {
+			clearInternalMemory(ctx.secret)
 		}
 	}
 
-	result := state.Sum(nil)
-	for i, v := range result {
-		blockhash[i] = v
+	state.updateUint32(uint32(len(ctx.ad)))
+	if ctx.ad != nil {
+		state.update(ctx.ad)
 	}
 
-	return nil
+	state.final(blockhash[:prehashDigestLength])
 }
 
 func fillFirstBlocks(blockhash *[prehashSeedLength]byte, ins *instance) error {
-	blockhashBytes := make([]byte, blockSize)
+	var blockhashBytes [blockSize]byte
+	defer clearInternalMemory(blockhashBytes[:])
+
 	for l := uint32(0); l < ins.lanes; l++ {
 		binary.LittleEndian.PutUint32(blockhash[prehashDigestLength:], 0)
 		binary.LittleEndian.PutUint32(blockhash[prehashDigestLength+4:], l)
-		if err := blakeLong(blockhashBytes, blockhash[:]); err != nil {
+		if err := blakeLong(blockhashBytes[:], blockhash[:]); err != nil {
 			return err
 		}
-		loadBlock(&ins.memory[l*ins.laneLength], blockhashBytes)
+		loadBlock(&ins.memory[l*ins.laneLength], blockhashBytes[:])
 
 		binary.LittleEndian.PutUint32(blockhash[prehashDigestLength:], 1)
-		if err := blakeLong(blockhashBytes, blockhash[:]); err != nil {
+		if err := blakeLong(blockhashBytes[:], blockhash[:]); err != nil {
 			return err
 		}
-		loadBlock(&ins.memory[l*ins.laneLength+1], blockhashBytes)
+		loadBlock(&ins.memory[l*ins.laneLength+1], blockhashBytes[:])
 	}
 	return nil
 }
@@ -1,10 +1,11 @@
 package argon2
 
+import (
+	"context"
+)
+
 /* Argon2 internal constants */
 const (
-	// Version of the algorithm
-	versionNumber = 0x10
-
 	// Memory block size in bytes
 	blockSize     = 1024
 	qwordsInBlock = blockSize / 8
@@ -35,6 +36,10 @@ type instance struct {
 	lanes         uint32
 	threads       uint32
 	variant       Variant
+	version       Version
+	cancel        context.Context // Nil if the derivation can not be cancelled
+	progress      ProgressFunc    // Nil if the progress is not reported
+	labels        context.Context // Nil if the caller has no pprof labels
 }
 
 // Argon2 position: where we construct the block right now. Used to
@@ -2,26 +2,36 @@ package argon2
 
 func fillSegment(ins *instance, pos *position) {
 	var (
-		refBlock, currBlock           *block
-		pseudoRand, refIndex, refLane uint64
-		prevOffset, currOffset        uint32
-		startingIndex                 uint32
-		dataIndependentAddressing     bool
-		pseudoRands                   []uint64
+		refBlock, currBlock                                  *block
+		pseudoRand, refIndex, refLane                        uint64
+		prevOffset, currOffset                               uint32
+		startingIndex                                        uint32
+		dataIndependentAddressing                            bool
+		zeroBlock, inputBlock, addressBlock, tmpAddressBlock block
 	)
 	if ins == nil {
 		return
 	}
 
-	dataIndependentAddressing = (ins.variant == Argon2i)
+	dataIndependentAddressing = (ins.variant == Argon2i) ||
+		(ins.variant == Argon2id && pos.pass == 0 && pos.slice < syncPoints/2)
 
 	if dataIndependentAddressing {
-		pseudoRands = make([]uint64, ins.segmentLength)
-		generateAddresses(ins, pos, pseudoRands)
+		inputBlock[0] = uint64(pos.pass)
+		inputBlock[1] = uint64(pos.lane)
+		inputBlock[2] = uint64(pos.slice)
+		inputBlock[3] = uint64(ins.memoryBlocks)
+		inputBlock[4] = uint64(ins.passes)
+		inputBlock[5] = uint64(ins.variant)
 	}
 
 	if pos.pass == 0 && pos.slice == 0 {
 		startingIndex = 2 // We have already generated the first two blocks
+
+		/* Don't forget to generate the first block of addresses */
+		if dataIndependentAddressing {
+			nextAddresses(&addressBlock, &inputBlock, &zeroBlock, &tmpAddressBlock)
+		}
 	}
 
 	// Calculate offset of the current block
@@ -36,6 +46,12 @@ func fillSegment(ins *instance, pos *position) {
 	}
 
 	for i := startingIndex; i < ins.segmentLength; i++ {
+		/* 1.0 Give up on the segment once cancelled, the caller returns
+		   the error at the next synchronization point */
+		if i%addressesInBlock == 0 && ins.cancelled() != nil {
+			return
+		}
+
 		/* 1.1 Rotating prev_offest if needed */
 		if currOffset%ins.laneLength == 1 {
 			prevOffset = currOffset - 1
@@ -44,7 +60,10 @@ func fillSegment(ins *instance, pos *position) {
 		/* 1.2 Computing the index of the reference block */
 		/* 1.2.1 Taking pseudo-random value from the previous block */
 		if dataIndependentAddressing {
-			pseudoRand = pseudoRands[i]
+			if i%addressesInBlock == 0 {
+				nextAddresses(&addressBlock, &inputBlock, &zeroBlock, &tmpAddressBlock)
+			}
+			pseudoRand = addressBlock[i%addressesInBlock]
 		} else {
 			pseudoRand = ins.memory[prevOffset][0]
 		}
@@ -66,35 +85,26 @@ func fillSegment(ins *instance, pos *position) {
 			&ins.memory[uint64(ins.laneLength)*refLane+refIndex]
 		//log.Printf("%d/%d\n", currOffset, len(ins.memory))
 		currBlock = &ins.memory[currOffset]
-		round(currBlock, refBlock, &ins.memory[prevOffset])
+		if ins.version == V10 || pos.pass == 0 {
+			round(currBlock, refBlock, &ins.memory[prevOffset])
+		} else {
+			// Version 1.3 XORs the new block into the old one
+			oldBlock := *currBlock
+			round(currBlock, refBlock, &ins.memory[prevOffset])
+			xorBlock(currBlock, &oldBlock)
+		}
 		currOffset++
 		prevOffset++
 	}
 }
 
-func generateAddresses(ins *instance, pos *position, pseudoRands []uint64) {
-	var zeroBlock, inputBlock, addressBlock, tmpBlock block
-
-	if ins == nil || pos == nil {
-		return
-	}
-
-	inputBlock[0] = uint64(pos.pass)
-	inputBlock[1] = uint64(pos.lane)
-	inputBlock[2] = uint64(pos.slice)
-	inputBlock[3] = uint64(ins.memoryBlocks)
-	inputBlock[4] = uint64(ins.passes)
-	inputBlock[5] = uint64(ins.variant)
-
-	for i := uint32(0); i < ins.segmentLength; i++ {
-		if i%addressesInBlock == 0 {
-			inputBlock[6]++
-			copy(tmpBlock[:], addressBlock[:])
-			round(&inputBlock, &addressBlock, &zeroBlock)
-			round(&addressBlock, &tmpBlock, &zeroBlock)
-		}
-		pseudoRands[i] = addressBlock[i%addressesInBlock]
-	}
+// nextAddresses generates the next addressesInBlock pseudo-random values of
+// the data-independent addressing into addressBlock. round can not work in
+// place, so tmpBlock holds the intermediate block.
+func nextAddresses(addressBlock, inputBlock, zeroBlock, tmpBlock *block) {
+	inputBlock[6]++
+	round(tmpBlock, zeroBlock, inputBlock)
+	round(addressBlock, zeroBlock, tmpBlock)
 }
 
 func xorBlock(dst, src *block) {
@@ -1,6 +1,9 @@
 package argon2
 
 import (
+	"context"
+	"runtime/pprof"
+	"strconv"
 	"sync"
 )
 
@@ -9,15 +12,64 @@ func fillMemoryBlocks(ins *instance) error {
 		return ErrThreadFail
 	}
 
+	/* 1. Never start more threads than there are lanes */
+	threads := ins.threads
+	if threads == 0 || threads > ins.lanes {
+		threads = ins.lanes
+	}
+
+	if err := ins.cancelled(); err != nil {
+		return err
+	}
+
+	/* 2. Label every lane on top of the pprof labels of the caller, so
+	   that the profiles break the CPU time down per lane */
+	labels := ins.labels
+	if labels == nil {
+		labels = context.Background()
+	}
+
+	laneLabels := make([]context.Context, ins.lanes)
+	for l := range laneLabels {
+		laneLabels[l] = pprof.WithLabels(labels, pprof.Labels("argon2.lane", strconv.Itoa(l)))
+	}
+
+	/* 3. Start the threads, they live for the whole derivation and meet
+	   at the barrier after every slice. The caller waits for them, its
+	   own labels are left untouched. */
+	var (
+		wg  sync.WaitGroup
+		bar = newBarrier(threads)
+	)
+
+	for t := uint32(0); t < threads; t++ {
+		wg.Add(1)
+
+		go func(first uint32) {
+			defer wg.Done()
+
+			fillLanes(ins, bar, laneLabels, first, threads)
+		}(t)
+	}
+
+	wg.Wait()
+
+	return ins.cancelled()
+}
+
+// fillLanes is the body of a single thread. It fills every threads-th lane,
+// starting with first, of every slice of every pass, under the pprof labels
+// of the lane.
+func fillLanes(ins *instance, bar *barrier, laneLabels []context.Context, first, threads uint32) {
 	for r := uint32(0); r < ins.passes; r++ {
 		for s := uint32(0); s < syncPoints; s++ {
-			var wg sync.WaitGroup
-
-			/* 2. Calling threads */
-			for l := uint32(0); l < ins.lanes; l++ {
-				wg.Add(1)
+			for l := first; l < ins.lanes; l += threads {
+				/* 2.1 Skip the remaining segments if cancelled */
+				if ins.cancelled() != nil {
+					break
+				}
 
-				/* 2.2 Create thread */
+				/* 2.2 Create position */
 				pos := position{
 					pass:  r,
 					lane:  l,
@@ -25,15 +77,73 @@ func fillMemoryBlocks(ins *instance) error {
 					index: 0,
 				}
 
-				go func(ins *instance, pos *position) {
-					defer wg.Done()
-					fillSegment(ins, pos)
-				}(ins, &pos)
+				pprof.SetGoroutineLabels(laneLabels[l])
+				fillSegment(ins, &pos)
 			}
 
-			wg.Wait()
+			/* 2.3 Synchronize, the last thread to finish the slice
+			   checks the cancellation and reports the progress */
+			stop := bar.wait(func() bool {
+				if ins.cancelled() != nil {
+					return true
+				}
+
+				if ins.progress != nil {
+					ins.progress(r, s, ins.passes)
+				}
+				return false
+			})
+			if stop {
+				return
+			}
 		}
 	}
+}
+
+// cancelled returns the error of the cancelled context, nil otherwise.
+func (ins *instance) cancelled() error {
+	if ins.cancel == nil {
+		return nil
+	}
+
+	return ins.cancel.Err()
+}
+
+// barrier is a reusable synchronization point of a fixed number of threads.
+type barrier struct {
+	mu      sync.Mutex
+	cond    sync.Cond
+	parties uint32
+	waiting uint32
+	phase   uint64
+	stop    bool
+}
+
+func newBarrier(parties uint32) *barrier {
+	b := &barrier{parties: parties}
+	b.cond.L = &b.mu
+	return b
+}
+
+// wait blocks until all the threads arrive. The last one runs action before
+// the others are released, its result is returned to all of them.
+func (b *barrier) wait(action func() bool) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.waiting++
+	if b.waiting == b.parties {
+		b.stop = action()
+		b.waiting = 0
+		b.phase++
+		b.cond.Broadcast()
+		return b.stop
+	}
+
+	phase := b.phase
+	for phase == b.phase {
+		b.cond.Wait()
+	}
 
-	return nil
+	return b.stop
 }
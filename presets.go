@@ -0,0 +1,59 @@
+package argon2
+
+// Parameter presets for Argon2id. They only set the costs, the variant, the
+// version and the key length, so they can be passed to GenerateFromPassword
+// or NeedsRehash as they are, or completed with a password and a salt for
+// Derive. Every call returns a new Params, so a caller changing it can not
+// weaken the presets of the rest of the process.
+
+// RFC9106FirstRecommended is the first recommended option of RFC 9106,
+// section 4: t=1, m=2 GiB, p=4. It assumes 2 GiB of memory to spare for
+// every concurrent derivation.
+func RFC9106FirstRecommended() Params { return preset(1, 2*1024*1024, 4) }
+
+// RFC9106SecondRecommended is the second recommended option of RFC 9106,
+// section 4, for memory-constrained environments: t=3, m=64 MiB, p=4.
+func RFC9106SecondRecommended() Params { return preset(3, 64*1024, 4) }
+
+// The equally strong Argon2id configurations of the OWASP Password Storage
+// Cheat Sheet, trading memory for passes. OWASP19MiB is the one listed
+// first by the cheat sheet.
+
+// OWASP46MiB is t=1, m=46 MiB, p=1.
+func OWASP46MiB() Params { return preset(1, 46*1024, 1) }
+
+// OWASP19MiB is t=2, m=19 MiB, p=1.
+func OWASP19MiB() Params { return preset(2, 19*1024, 1) }
+
+// OWASP12MiB is t=3, m=12 MiB, p=1.
+func OWASP12MiB() Params { return preset(3, 12*1024, 1) }
+
+// OWASP9MiB is t=4, m=9 MiB, p=1.
+func OWASP9MiB() Params { return preset(4, 9*1024, 1) }
+
+// OWASP7MiB is t=5, m=7 MiB, p=1.
+func OWASP7MiB() Params { return preset(5, 7*1024, 1) }
+
+// The Argon2id limits of libsodium, crypto_pwhash_OPSLIMIT_* and
+// crypto_pwhash_MEMLIMIT_*. libsodium always uses a single lane.
+
+// Interactive is meant for online operations: t=2, m=64 MiB.
+func Interactive() Params { return preset(2, 64*1024, 1) }
+
+// Moderate takes about 0.7 seconds on a 2.8 GHz Core i7: t=3, m=256 MiB.
+func Moderate() Params { return preset(3, 256*1024, 1) }
+
+// Sensitive takes about 3.5 seconds on a 2.8 GHz Core i7 and is meant for
+// highly sensitive and non-interactive operations: t=4, m=1 GiB.
+func Sensitive() Params { return preset(4, 1024*1024, 1) }
+
+func preset(time, memory, lanes uint32) Params {
+	return Params{
+		Time:      time,
+		Memory:    memory,
+		Lanes:     lanes,
+		KeyLength: DefaultKeyLength,
+		Variant:   Argon2id,
+		Version:   V13,
+	}
+}
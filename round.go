@@ -29,7 +29,7 @@ LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY
  POSSIBILITY OF SUCH DAMAGE.
 */
 
-func round(z, a, b *block) {
+func roundGeneric(z, a, b *block) {
 	z[0] = a[0] ^ b[0]
 	z[1] = a[1] ^ b[1]
 	z[2] = a[2] ^ b[2]
@@ -0,0 +1,31 @@
+//go:build amd64 && gc && !purego
+
+package argon2
+
+import (
+	"golang.org/x/sys/cpu"
+)
+
+//go:generate sh -c "go run round_amd64_gen.go > round_amd64.s"
+
+var (
+	useAVX2 = cpu.X86.HasAVX2
+	useSSE4 = cpu.X86.HasSSE41
+)
+
+//go:noescape
+func roundAVX2(z, a, b *block)
+
+//go:noescape
+func roundSSE4(z, a, b *block)
+
+func round(z, a, b *block) {
+	switch {
+	case useAVX2:
+		roundAVX2(z, a, b)
+	case useSSE4:
+		roundSSE4(z, a, b)
+	default:
+		roundGeneric(z, a, b)
+	}
+}
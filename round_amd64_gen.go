@@ -0,0 +1,307 @@
+//go:build ignore
+
+// This program generates round_amd64.s, the AVX2 and SSE4.1 versions of the
+// round function in round.go. Run it with:
+//
+//	go run round_amd64_gen.go > round_amd64.s
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+var out strings.Builder
+
+func emit(format string, args ...interface{}) {
+	fmt.Fprintf(&out, "\t"+format+"\n", args...)
+}
+
+func main() {
+	out.WriteString("// Code generated by go run round_amd64_gen.go. DO NOT EDIT.\n\n")
+	out.WriteString("//go:build amd64 && gc && !purego\n\n")
+	out.WriteString("#include \"textflag.h\"\n\n")
+
+	constants()
+	avx2()
+	sse4()
+
+	os.Stdout.WriteString(out.String())
+}
+
+// constants emits the PSHUFB masks rotating every 64-bit word right by 24
+// and 16 bits, repeated for both 128-bit lanes of the AVX2 registers.
+func constants() {
+	masks := []struct {
+		name  string
+		words [2]uint64
+	}{
+		{"rot24", [2]uint64{0x0201000706050403, 0x0a09080f0e0d0c0b}},
+		{"rot16", [2]uint64{0x0100070605040302, 0x09080f0e0d0c0b0a}},
+	}
+
+	for _, m := range masks {
+		for i := 0; i < 4; i++ {
+			fmt.Fprintf(&out, "DATA ·%s<>+%d(SB)/8, $0x%016x\n", m.name, i*8, m.words[i%2])
+		}
+		fmt.Fprintf(&out, "GLOBL ·%s<>(SB), (NOPTR+RODATA), $32\n\n", m.name)
+	}
+}
+
+// avx2 emits roundAVX2. Every YMM register holds a row of four words of the
+// 4x4 matrix permuted by _P, two permutations are interleaved to hide the
+// latencies: Y0-Y3 and Y4-Y7 hold the matrices, Y8 and Y9 are temporaries,
+// Y10 and Y11 the rotation masks.
+func avx2() {
+	out.WriteString("// func roundAVX2(z, a, b *block)\n")
+	out.WriteString("// Requires: AVX, AVX2\n")
+	out.WriteString("TEXT ·roundAVX2(SB), NOSPLIT, $0-24\n")
+	emit("MOVQ z+0(FP), AX")
+	emit("MOVQ a+8(FP), BX")
+	emit("MOVQ b+16(FP), CX")
+	emit("VMOVDQU ·rot24<>(SB), Y10")
+	emit("VMOVDQU ·rot16<>(SB), Y11")
+
+	// z = a ^ b
+	for i := 0; i < 32; i++ {
+		emit("VMOVDQU %d(BX), Y%d", i*32, i%8)
+		emit("VPXOR %d(CX), Y%d, Y%d", i*32, i%8, i%8)
+		emit("VMOVDQU Y%d, %d(AX)", i%8, i*32)
+	}
+
+	// Rows: _P(z[16*i], ..., z[16*i+15])
+	for i := 0; i < 8; i += 2 {
+		for m := 0; m < 8; m++ {
+			emit("VMOVDQU %d(AX), Y%d", i*128+m*32, m)
+		}
+		permuteAVX2()
+		for m := 0; m < 8; m++ {
+			emit("VMOVDQU Y%d, %d(AX)", m, i*128+m*32)
+		}
+	}
+
+	// Columns: _P(z[2*i], z[2*i+1], z[2*i+16], z[2*i+17], ..., z[2*i+113])
+	for i := 0; i < 8; i += 2 {
+		for m := 0; m < 8; m++ {
+			offset := (i+m/4)*16 + (m%4)*256
+			emit("VMOVDQU %d(AX), X%d", offset, m)
+			emit("VINSERTI128 $1, %d(AX), Y%d, Y%d", offset+128, m, m)
+		}
+		permuteAVX2()
+		for m := 0; m < 8; m++ {
+			offset := (i+m/4)*16 + (m%4)*256
+			emit("VMOVDQU X%d, %d(AX)", m, offset)
+			emit("VEXTRACTI128 $1, Y%d, %d(AX)", m, offset+128)
+		}
+	}
+
+	// z ^= a ^ b
+	for i := 0; i < 32; i++ {
+		emit("VMOVDQU %d(BX), Y%d", i*32, i%8)
+		emit("VPXOR %d(CX), Y%d, Y%d", i*32, i%8, i%8)
+		emit("VPXOR %d(AX), Y%d, Y%d", i*32, i%8, i%8)
+		emit("VMOVDQU Y%d, %d(AX)", i%8, i*32)
+	}
+
+	emit("VZEROUPPER")
+	emit("RET")
+	out.WriteString("\n")
+}
+
+// permuteAVX2 applies _P to the two matrices in Y0-Y3 and Y4-Y7.
+func permuteAVX2() {
+	gAVX2()
+	for _, base := range []int{0, 4} {
+		emit("VPERMQ $0x39, Y%d, Y%d", base+1, base+1)
+		emit("VPERMQ $0x4e, Y%d, Y%d", base+2, base+2)
+		emit("VPERMQ $0x93, Y%d, Y%d", base+3, base+3)
+	}
+	gAVX2()
+	for _, base := range []int{0, 4} {
+		emit("VPERMQ $0x93, Y%d, Y%d", base+1, base+1)
+		emit("VPERMQ $0x4e, Y%d, Y%d", base+2, base+2)
+		emit("VPERMQ $0x39, Y%d, Y%d", base+3, base+3)
+	}
+}
+
+// gAVX2 applies the G function to the columns of both matrices.
+func gAVX2() {
+	each := func(format string, regs ...int) {
+		for _, base := range []int{0, 4} {
+			args := make([]interface{}, len(regs))
+			for i, r := range regs {
+				if r == 8 {
+					args[i] = 8 + base/4 // Temporary of the matrix
+				} else {
+					args[i] = base + r
+				}
+			}
+			emit(format, args...)
+		}
+	}
+
+	// x += y + 2*lo(x)*lo(y)
+	fBlaMka := func(x, y int) {
+		each("VPMULUDQ Y%d, Y%d, Y%d", y, x, 8)
+		each("VPADDQ Y%d, Y%d, Y%d", y, x, x)
+		each("VPADDQ Y%d, Y%d, Y%d", 8, x, x)
+		each("VPADDQ Y%d, Y%d, Y%d", 8, x, x)
+	}
+
+	fBlaMka(0, 1)
+	each("VPXOR Y%d, Y%d, Y%d", 0, 3, 3)
+	each("VPSHUFD $0xb1, Y%d, Y%d", 3, 3)
+	fBlaMka(2, 3)
+	each("VPXOR Y%d, Y%d, Y%d", 2, 1, 1)
+	for _, base := range []int{0, 4} {
+		emit("VPSHUFB Y10, Y%d, Y%d", base+1, base+1)
+	}
+	fBlaMka(0, 1)
+	each("VPXOR Y%d, Y%d, Y%d", 0, 3, 3)
+	for _, base := range []int{0, 4} {
+		emit("VPSHUFB Y11, Y%d, Y%d", base+3, base+3)
+	}
+	fBlaMka(2, 3)
+	each("VPXOR Y%d, Y%d, Y%d", 2, 1, 1)
+	each("VPADDQ Y%d, Y%d, Y%d", 1, 1, 8)
+	each("VPSRLQ $63, Y%d, Y%d", 1, 1)
+	each("VPXOR Y%d, Y%d, Y%d", 8, 1, 1)
+}
+
+// sse4 emits roundSSE4. Every XMM register holds half of a row of the 4x4
+// matrix permuted by _P: X0-X7 hold the matrix, X8 and X9 are temporaries
+// of the two interleaved G functions, X10 and X11 the rotation masks and
+// X12 and X13 are used to move the words between the rows.
+func sse4() {
+	out.WriteString("// func roundSSE4(z, a, b *block)\n")
+	out.WriteString("// Requires: SSE2, SSSE3\n")
+	out.WriteString("TEXT ·roundSSE4(SB), NOSPLIT, $0-24\n")
+	emit("MOVQ z+0(FP), AX")
+	emit("MOVQ a+8(FP), BX")
+	emit("MOVQ b+16(FP), CX")
+	emit("MOVOU ·rot24<>(SB), X10")
+	emit("MOVOU ·rot16<>(SB), X11")
+
+	// z = a ^ b
+	for i := 0; i < 64; i++ {
+		emit("MOVOU %d(BX), X%d", i*16, i%8)
+		emit("MOVOU %d(CX), X%d", i*16, 8+i%2)
+		emit("PXOR X%d, X%d", 8+i%2, i%8)
+		emit("MOVOU X%d, %d(AX)", i%8, i*16)
+	}
+
+	// Rows: _P(z[16*i], ..., z[16*i+15])
+	for i := 0; i < 8; i++ {
+		for m := 0; m < 8; m++ {
+			emit("MOVOU %d(AX), X%d", i*128+m*16, m)
+		}
+		permuteSSE4()
+		for m := 0; m < 8; m++ {
+			emit("MOVOU X%d, %d(AX)", m, i*128+m*16)
+		}
+	}
+
+	// Columns: _P(z[2*i], z[2*i+1], z[2*i+16], z[2*i+17], ..., z[2*i+113])
+	for i := 0; i < 8; i++ {
+		for m := 0; m < 8; m++ {
+			emit("MOVOU %d(AX), X%d", i*16+m*128, m)
+		}
+		permuteSSE4()
+		for m := 0; m < 8; m++ {
+			emit("MOVOU X%d, %d(AX)", m, i*16+m*128)
+		}
+	}
+
+	// z ^= a ^ b
+	for i := 0; i < 64; i++ {
+		emit("MOVOU %d(BX), X%d", i*16, i%8)
+		emit("MOVOU %d(CX), X%d", i*16, 8+i%2)
+		emit("PXOR X%d, X%d", 8+i%2, i%8)
+		emit("MOVOU %d(AX), X%d", i*16, 8+i%2)
+		emit("PXOR X%d, X%d", 8+i%2, i%8)
+		emit("MOVOU X%d, %d(AX)", i%8, i*16)
+	}
+
+	emit("RET")
+}
+
+// permuteSSE4 applies _P to the matrix in X0-X7, the rows are (X0, X1),
+// (X2, X3), (X4, X5) and (X6, X7).
+func permuteSSE4() {
+	gSSE4([2][4]int{{0, 2, 4, 6}, {1, 3, 5, 7}})
+
+	// Rotate the second row left by one word and the fourth one right,
+	// the third row is rotated by swapping X4 and X5 in the G function.
+	align(12, 3, 2)
+	align(13, 2, 3)
+	emit("MOVO X12, X2")
+	emit("MOVO X13, X3")
+	align(12, 6, 7)
+	align(13, 7, 6)
+	emit("MOVO X12, X6")
+	emit("MOVO X13, X7")
+
+	gSSE4([2][4]int{{0, 2, 5, 6}, {1, 3, 4, 7}})
+
+	align(12, 2, 3)
+	align(13, 3, 2)
+	emit("MOVO X12, X2")
+	emit("MOVO X13, X3")
+	align(12, 7, 6)
+	align(13, 6, 7)
+	emit("MOVO X12, X6")
+	emit("MOVO X13, X7")
+}
+
+// align sets dst to the high word of lo followed by the low word of hi.
+func align(dst, hi, lo int) {
+	emit("MOVO X%d, X%d", hi, dst)
+	emit("PALIGNR $8, X%d, X%d", lo, dst)
+}
+
+// gSSE4 applies the G function to two columns of two words each.
+func gSSE4(cols [2][4]int) {
+	each := func(format string, regs ...int) {
+		for c := range cols {
+			args := make([]interface{}, len(regs))
+			for i, r := range regs {
+				if r == 8 {
+					args[i] = 8 + c // Temporary of the column
+				} else {
+					args[i] = cols[c][r]
+				}
+			}
+			emit(format, args...)
+		}
+	}
+
+	// x += y + 2*lo(x)*lo(y)
+	fBlaMka := func(x, y int) {
+		each("MOVO X%d, X%d", x, 8)
+		each("PMULULQ X%d, X%d", y, 8)
+		each("PADDQ X%d, X%d", y, x)
+		each("PADDQ X%d, X%d", 8, x)
+		each("PADDQ X%d, X%d", 8, x)
+	}
+
+	fBlaMka(0, 1)
+	each("PXOR X%d, X%d", 0, 3)
+	each("PSHUFD $0xb1, X%d, X%d", 3, 3)
+	fBlaMka(2, 3)
+	each("PXOR X%d, X%d", 2, 1)
+	for _, col := range cols {
+		emit("PSHUFB X10, X%d", col[1])
+	}
+	fBlaMka(0, 1)
+	each("PXOR X%d, X%d", 0, 3)
+	for _, col := range cols {
+		emit("PSHUFB X11, X%d", col[3])
+	}
+	fBlaMka(2, 3)
+	each("PXOR X%d, X%d", 2, 1)
+	each("MOVO X%d, X%d", 1, 8)
+	each("PADDQ X%d, X%d", 1, 8)
+	each("PSRLQ $63, X%d", 1)
+	each("PXOR X%d, X%d", 8, 1)
+}
@@ -0,0 +1,69 @@
+//go:build amd64 && gc && !purego
+
+package argon2
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var roundImplementations = []struct {
+	name      string
+	supported bool
+	round     func(z, a, b *block)
+}{
+	{"AVX2", useAVX2, roundAVX2},
+	{"SSE4", useSSE4, roundSSE4},
+	{"Generic", true, roundGeneric},
+}
+
+func randomBlock(rng *rand.Rand) *block {
+	var b block
+	for i := range b {
+		b[i] = rng.Uint64()
+	}
+	return &b
+}
+
+func TestRoundAssembly(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, impl := range roundImplementations {
+		if !impl.supported {
+			t.Logf("%s is not supported by the CPU, skipping", impl.name)
+			continue
+		}
+
+		for i := 0; i < 1000; i++ {
+			a, b := randomBlock(rng), randomBlock(rng)
+
+			var want, got block
+			roundGeneric(&want, a, b)
+			impl.round(&got, a, b)
+
+			if got != want {
+				t.Fatalf("%s: output differs from roundGeneric", impl.name)
+			}
+		}
+	}
+}
+
+func BenchmarkRound(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x, y := randomBlock(rng), randomBlock(rng)
+
+	for _, impl := range roundImplementations {
+		if !impl.supported {
+			continue
+		}
+
+		b.Run(impl.name, func(b *testing.B) {
+			b.SetBytes(blockSize)
+
+			var z block
+			for n := 0; n < b.N; n++ {
+				impl.round(&z, x, y)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+//go:build !amd64 || !gc || purego
+
+package argon2
+
+func round(z, a, b *block) {
+	roundGeneric(z, a, b)
+}
@@ -0,0 +1,122 @@
+package argon2
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	selfTestOnce   sync.Once
+	selfTestFailed atomic.Bool
+	selfTestRuns   atomic.Uint32 // Only read by the tests
+)
+
+// Expected outputs of the self-test. The BLAKE2b one is blakeLong with a
+// 72 byte output of "abc", the round one the 32 byte blakeLong digest of
+// round applied to two fixed blocks and the core one the Argon2id test
+// vector of RFC 9106, section 5.3.
+var (
+	selfTestBlake = mustDecodeHex("13ac1def3e362ae0a78cdcb810a81885c95926cabee9dee40b46f9fc31cfa58a" +
+		"3e4e098a7beea541ca0b9002f89434898fc85990e937d91ddd70754b10d5316ad4fd8cd64564db92")
+	selfTestRound = mustDecodeHex("b87dcd85bc1888a6dcec92ed2b1e69ba6cac6cf02950f4c14d34b8935eb7749b")
+	selfTestCore  = mustDecodeHex("0d640df58d78766c08c037a34a8b53c9d01ef0452d75b65eb52520e96b01e659")
+)
+
+// SelfTest runs the known-answer tests of BLAKE2b, of the compression
+// function and of a complete Argon2id derivation, and returns
+// ErrSelfTestFailed if any of them fails. The same tests run automatically
+// before the first derivation of the process, unless SelfTest or
+// DisableSelfTest is called first. A failure of either is permanent: every
+// later derivation returns ErrSelfTestFailed.
+func SelfTest() error {
+	if runSelfTest() != nil {
+		selfTestFailed.Store(true)
+	}
+
+	// The first derivation does not need to run it again
+	selfTestOnce.Do(func() {})
+
+	if selfTestFailed.Load() {
+		return ErrSelfTestFailed
+	}
+	return nil
+}
+
+// DisableSelfTest skips the self-test before the first derivation of the
+// process, for the short-lived processes that can not afford its cost. It
+// has no effect once a derivation has started. SelfTest still runs it on
+// demand.
+func DisableSelfTest() {
+	selfTestOnce.Do(func() {})
+}
+
+// checkSelfTest runs the self-test on the first call and returns
+// ErrSelfTestFailed if it has ever failed.
+func checkSelfTest() error {
+	selfTestOnce.Do(func() {
+		if runSelfTest() != nil {
+			selfTestFailed.Store(true)
+		}
+	})
+
+	if selfTestFailed.Load() {
+		return ErrSelfTestFailed
+	}
+	return nil
+}
+
+func runSelfTest() error {
+	selfTestRuns.Add(1)
+
+	/* 1. BLAKE2b, with an output longer than a single digest */
+	var digest [72]byte
+	if err := blakeLong(digest[:], []byte("abc")); err != nil || !bytes.Equal(digest[:], selfTestBlake) {
+		return ErrSelfTestFailed
+	}
+
+	/* 2. The compression function, as selected for this CPU */
+	var (
+		a, b, z block
+		buffer  [blockSize]byte
+	)
+	for i := range a {
+		a[i] = uint64(i) * 0x0101010101010101
+		b[i] = ^a[i]
+	}
+
+	round(&z, &a, &b)
+	storeBlock(buffer[:], &z)
+	if err := blakeLong(digest[:32], buffer[:]); err != nil || !bytes.Equal(digest[:32], selfTestRound) {
+		return ErrSelfTestFailed
+	}
+
+	/* 3. A complete derivation, which must not fail because of the
+	   memory governor */
+	ctx := argon2Context{
+		out:        digest[:32],
+		pwd:        bytes.Repeat([]byte{1}, 32),
+		salt:       bytes.Repeat([]byte{2}, 16),
+		secret:     bytes.Repeat([]byte{3}, 8),
+		ad:         bytes.Repeat([]byte{4}, 12),
+		timeCost:   3,
+		memoryCost: 32,
+		lanes:      4,
+		threads:    4,
+		ungoverned: true,
+	}
+	if err := core(&ctx, Argon2id, V13); err != nil || !bytes.Equal(digest[:32], selfTestCore) {
+		return ErrSelfTestFailed
+	}
+
+	return nil
+}
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,62 @@
+package argon2
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSelfTest(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// countSelfTestRuns resets the self-test state of the process, calls setup
+// and then checkSelfTest, and returns the runs of the self-test made by
+// checkSelfTest.
+func countSelfTestRuns(t *testing.T, setup func()) uint32 {
+	t.Helper()
+
+	selfTestOnce = sync.Once{}
+	setup()
+
+	before := selfTestRuns.Load()
+	if err := checkSelfTest(); err != nil {
+		t.Fatal(err)
+	}
+	return selfTestRuns.Load() - before
+}
+
+func TestSelfTestOnce(t *testing.T) {
+	if n := countSelfTestRuns(t, func() {}); n != 1 {
+		t.Errorf("the first derivation ran the self-test %d times, want once", n)
+	}
+
+	if n := countSelfTestRuns(t, func() { SelfTest() }); n != 0 {
+		t.Errorf("the first derivation ran the self-test again after SelfTest, %d times", n)
+	}
+
+	if n := countSelfTestRuns(t, DisableSelfTest); n != 0 {
+		t.Errorf("the first derivation ran the self-test after DisableSelfTest, %d times", n)
+	}
+}
+
+func TestSelfTestFailed(t *testing.T) {
+	if err := checkSelfTest(); err != nil {
+		t.Fatal(err)
+	}
+
+	selfTestFailed.Store(true)
+	defer selfTestFailed.Store(false)
+
+	if _, err := Derive(Params{}); err != ErrSelfTestFailed {
+		t.Errorf("Derive: %v, want ErrSelfTestFailed", err)
+	}
+	if _, _, err := Calibrate(time.Second, 64, 1, Argon2id); err != ErrSelfTestFailed {
+		t.Errorf("Calibrate: %v, want ErrSelfTestFailed", err)
+	}
+	if _, err := EstimateCost(Params{Time: 1, Memory: 64, Lanes: 1, KeyLength: 32}); err != ErrSelfTestFailed {
+		t.Errorf("EstimateCost: %v, want ErrSelfTestFailed", err)
+	}
+}